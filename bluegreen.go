@@ -0,0 +1,215 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Backend apps run in one of two slots. A deployment starts the new version
+// in the idle slot and only moves traffic once it is ready.
+const (
+	slotBlue  = "blue"
+	slotGreen = "green"
+)
+
+// slotInstance is the supervisor and port key of a site's slot
+func slotInstance(site, slot string) string {
+	return site + "@" + slot
+}
+
+func otherSlot(slot string) string {
+	if slot == slotBlue {
+		return slotGreen
+	}
+	return slotBlue
+}
+
+// slotTracker remembers which slot of each site receives traffic
+type slotTracker struct {
+	mu   sync.Mutex
+	path string
+	live map[string]string
+}
+
+func newSlotTracker(path string) *slotTracker {
+	t := &slotTracker{path: path, live: make(map[string]string)}
+	if data, err := os.ReadFile(path); err == nil {
+		if err := json.Unmarshal(data, &t.live); err != nil {
+			fmt.Printf("Warning: Could not parse %s: %v\n", path, err)
+		}
+	}
+	return t
+}
+
+// liveSlot returns the slot serving site, empty if there is none
+func (t *slotTracker) liveSlot(site string) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.live[site]
+}
+
+// sites returns every site with a live slot
+func (t *slotTracker) sites() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sites := make([]string, 0, len(t.live))
+	for site := range t.live {
+		sites = append(sites, site)
+	}
+	return sites
+}
+
+func (t *slotTracker) setLive(site, slot string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.live[site] = slot
+	t.save()
+}
+
+func (t *slotTracker) forget(site string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.live[site]; !ok {
+		return
+	}
+	delete(t.live, site)
+	t.save()
+}
+
+// save must be called with t.mu held
+func (t *slotTracker) save() {
+	data, _ := json.MarshalIndent(t.live, "", "  ")
+	if err := os.WriteFile(t.path, data, 0644); err != nil {
+		fmt.Printf("Warning: Failed to save live slots: %v\n", err)
+	}
+}
+
+// liveInstance is the supervisor name of the instance serving site. Apps
+// deployed before slots existed run under the bare site name.
+func liveInstance(site string) string {
+	if slot := slots.liveSlot(site); slot != "" {
+		return slotInstance(site, slot)
+	}
+	return site
+}
+
+// siteLocks serializes slot switches per site. Two deployments of a site
+// would otherwise start the same idle slot and replace each other.
+var siteLocks sync.Map
+
+// lockSite locks site and returns the function unlocking it
+func lockSite(site string) func() {
+	value, _ := siteLocks.LoadOrStore(site, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// switchSlots starts a new version of site in its idle slot, waits for it
+// to pass its health check and then points the site's route at it. The
+// previous instance keeps serving until the switch and is drained in the
+// background afterwards.
+func switchSlots(job *deployJob, spec appSpec) (string, error) {
+	site := spec.Name
+	defer lockSite(site)()
+	previous := liveInstance(site)
+	slot := otherSlot(slots.liveSlot(site))
+	instance := slotInstance(site, slot)
+
+	port, err := ports.allocate(instance)
+	if err != nil {
+		return "", err
+	}
+	spec.Name = instance
+	spec.Port = port
+	check := healthCheckWithDefaults(spec.HealthCheck)
+	spec.HealthCheck = &check
+
+	job.log.Printf("Starting %s slot on port %d\n", slot, port)
+	if err := apps.start(spec); err != nil {
+		apps.remove(instance)
+		return "", err
+	}
+
+	job.log.Printf("Waiting up to %ds for the %s health check to pass\n", check.Timeout, check.Type)
+	if err := waitReady(check, port); err != nil {
+		job.log.Printf("Health check failed: %v\n", err)
+		job.log.Printf("Stopping the %s slot, the previous instance keeps serving\n", slot)
+		apps.remove(instance)
+		return "", fmt.Errorf("health check failed: %v", err)
+	}
+	job.log.Printf("App is ready\n")
+
+	// Caddy applies a loaded config atomically, so requests go either to
+	// the old instance or to the new one
+	host := projectHost(site)
+	job.log.Printf("Routing %s to port %d\n", host, port)
+	if err := setCaddyRoute(host, proxyRoute(host, fmt.Sprintf("localhost:%d", port))); err != nil {
+		apps.remove(instance)
+		return "", fmt.Errorf("failed to route %s: %v", host, err)
+	}
+	slots.setLive(site, slot)
+
+	if _, ok := apps.spec(previous); ok && previous != instance {
+		job.log.Printf("Draining %s for %s\n", previous, drainGracePeriod)
+		go drainInstance(previous, drainGracePeriod)
+	}
+	return fmt.Sprintf("http://%s", host), nil
+}
+
+// drainInstance gives in-flight requests to a replaced instance time to
+// finish before stopping it
+func drainInstance(name string, grace time.Duration) {
+	if !apps.removeAfter(name, grace) {
+		return
+	}
+	// Slot ports are kept for the next deployment, only an instance from
+	// before slots existed gives its port back
+	if !isSlotInstance(name) {
+		ports.release(name)
+	}
+	fmt.Printf("Drained %s\n", name)
+}
+
+func isSlotInstance(name string) bool {
+	return strings.HasSuffix(name, "@"+slotBlue) || strings.HasSuffix(name, "@"+slotGreen)
+}
+
+// instanceSite is the site a supervised instance belongs to
+func instanceSite(name string) string {
+	if isSlotInstance(name) {
+		name, _, _ = strings.Cut(name, "@")
+	}
+	return name
+}
+
+// retireIdleSlots stops idle slot instances restored at boot, e.g. ones
+// that were still draining when Hoster went down
+func retireIdleSlots() {
+	slots.mu.Lock()
+	var idle []string
+	for site, slot := range slots.live {
+		idle = append(idle, slotInstance(site, otherSlot(slot)))
+	}
+	slots.mu.Unlock()
+
+	for _, name := range idle {
+		if _, ok := apps.spec(name); ok {
+			fmt.Printf("Stopping idle instance %s\n", name)
+			apps.remove(name)
+		}
+	}
+}
+
+// removeSiteApps stops every instance of site and frees their ports
+func removeSiteApps(site string) {
+	for _, name := range []string{site, slotInstance(site, slotBlue), slotInstance(site, slotGreen)} {
+		apps.remove(name)
+		ports.release(name)
+	}
+	slots.forget(site)
+}
@@ -0,0 +1,341 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BuildSpec describes a single build step such as `npm install`
+type BuildSpec struct {
+	// Dir is the host directory the command runs in
+	Dir  string
+	Args []string
+	// Env is added on top of a minimal base environment, never the
+	// server's own environment
+	Env []string
+	// Toolchain ("node", "go", "python", "hugo", "ruby" or "bun") selects
+	// the image used by container builds, and the installed version used by
+	// host builds
+	Toolchain string
+	// Runtime is the requested toolchain version, empty for the default
+	Runtime string
+	// Mounts are host directories outside Dir the step writes to, such as
+	// shared caches. Container builds mount them at the same path.
+	Mounts []string
+}
+
+// Builder runs build steps of untrusted repositories
+type Builder interface {
+	Run(ctx context.Context, spec BuildSpec, out io.Writer) error
+}
+
+// diskCheckInterval is how often a running container build's workspace
+// is measured against its disk limit
+var diskCheckInterval = 5 * time.Second
+
+// BuildLimits bounds the resources a single build step may use. Zero means
+// unlimited.
+type BuildLimits struct {
+	CPUs     float64
+	MemoryMB int
+	DiskMB   int
+	Timeout  time.Duration
+}
+
+// toolchainImage returns the container image for a toolchain, overridable
+// through HOSTER_<TOOLCHAIN>_IMAGE. A runtime version replaces the image's
+// tag.
+func toolchainImage(toolchain, runtime string) string {
+	var image string
+	switch toolchain {
+	case "node":
+		image = envOr("HOSTER_NODE_IMAGE", "node:20-bookworm")
+	case "go":
+		image = envOr("HOSTER_GO_IMAGE", "golang:1.24-bookworm")
+	case "python":
+		image = envOr("HOSTER_PYTHON_IMAGE", "python:3.12-bookworm")
+	case "hugo":
+		image = envOr("HOSTER_HUGO_IMAGE", "hugomods/hugo:exts")
+	case "ruby":
+		image = envOr("HOSTER_RUBY_IMAGE", "ruby:3.3-bookworm")
+	case "bun":
+		image = envOr("HOSTER_BUN_IMAGE", "oven/bun:1")
+	}
+	if image != "" && runtime != "" {
+		if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+			image = image[:i]
+		}
+		image += ":" + runtime
+	}
+	return image
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// baseEnvKeys are the variables of the server's environment that
+// baseEnv keeps
+var baseEnvKeys = []string{"PATH", "HOME", "TMPDIR", "LANG"}
+
+// baseEnv is the environment every build and app process starts from. It
+// deliberately leaves out the server's secrets.
+func baseEnv() []string {
+	env := []string{"CI=true"}
+	for _, key := range baseEnvKeys {
+		if value, ok := os.LookupEnv(key); ok {
+			env = append(env, key+"="+value)
+		}
+	}
+	return env
+}
+
+// buildLimitsFromEnv reads the HOSTER_BUILD_* limits
+func buildLimitsFromEnv() BuildLimits {
+	limits := BuildLimits{Timeout: 20 * time.Minute}
+	if v, err := strconv.ParseFloat(os.Getenv("HOSTER_BUILD_CPUS"), 64); err == nil {
+		limits.CPUs = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("HOSTER_BUILD_MEMORY_MB")); err == nil {
+		limits.MemoryMB = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("HOSTER_BUILD_DISK_MB")); err == nil {
+		limits.DiskMB = v
+	}
+	if v, err := time.ParseDuration(os.Getenv("HOSTER_BUILD_TIMEOUT")); err == nil {
+		limits.Timeout = v
+	}
+	return limits
+}
+
+// newBuilderFromEnv picks the builder configured through HOSTER_BUILDER
+// and its HOSTER_BUILD_* limits. Builds run in containers unless it is
+// set to "host".
+func newBuilderFromEnv() Builder {
+	limits := buildLimitsFromEnv()
+	switch mode := envOr("HOSTER_BUILDER", "container"); mode {
+	case "host":
+		fmt.Println("Warning: HOSTER_BUILDER=host runs builds unisolated on this machine")
+		return &hostBuilder{limits: limits}
+	case "container":
+	default:
+		fmt.Printf("Warning: Unknown HOSTER_BUILDER %q, using container\n", mode)
+	}
+	runtime := envOr("HOSTER_CONTAINER_RUNTIME", "docker")
+	if _, err := exec.LookPath(runtime); err != nil {
+		fmt.Printf("Warning: %s not found, builds will fail until it is installed or HOSTER_BUILDER=host is set\n", runtime)
+	}
+	return &containerBuilder{runtime: runtime, limits: limits}
+}
+
+// isolatedBuilds reports whether build steps run in containers, which
+// untrusted code requires
+func isolatedBuilds() bool {
+	_, ok := builder.(*containerBuilder)
+	return ok
+}
+
+// hostBuilder runs build steps directly on the host. It only enforces the
+// wall-clock and disk limits, use containerBuilder for real isolation.
+type hostBuilder struct {
+	limits BuildLimits
+}
+
+func (b *hostBuilder) Run(ctx context.Context, spec BuildSpec, out io.Writer) error {
+	if b.limits.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, b.limits.Timeout)
+		defer cancel()
+	}
+
+	env := append(baseEnv(), spec.Env...)
+	if spec.Runtime != "" {
+		if bin := toolchainBinDir(spec.Toolchain, spec.Runtime); bin != "" {
+			env = append(env, "PATH="+bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+		} else {
+			fmt.Fprintf(out, "Warning: %s %s is not installed in HOSTER_TOOLCHAINS_DIR, using the default\n", spec.Toolchain, spec.Runtime)
+		}
+	}
+
+	// Resolve the executable against the build's PATH, not ours
+	path := spec.Args[0]
+	for i := len(env) - 1; i >= 0; i-- {
+		if p, ok := strings.CutPrefix(env[i], "PATH="); ok {
+			if found, err := lookPathIn(path, p); err == nil {
+				path = found
+			}
+			break
+		}
+	}
+
+	cmd := exec.CommandContext(ctx, path, spec.Args[1:]...)
+	cmd.Dir = spec.Dir
+	cmd.Env = env
+	cmd.Stdout = out
+	cmd.Stderr = out
+	cmd.WaitDelay = 10 * time.Second
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("build step timed out after %s", b.limits.Timeout)
+		}
+		return err
+	}
+	return checkDiskLimit(spec.Dir, b.limits.DiskMB)
+}
+
+// lookPathIn finds an executable like exec.LookPath but in pathList
+func lookPathIn(file, pathList string) (string, error) {
+	if strings.ContainsRune(file, filepath.Separator) {
+		return file, nil
+	}
+	for _, dir := range filepath.SplitList(pathList) {
+		path := filepath.Join(dir, file)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() && info.Mode()&0111 != 0 {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("%s not found in PATH", file)
+}
+
+// containerBuilder runs each build step in a throwaway container with the
+// workspace bind mounted at the same path, CPU/memory/pid limits and no
+// access to the host environment
+type containerBuilder struct {
+	runtime string
+	limits  BuildLimits
+}
+
+func (b *containerBuilder) Run(ctx context.Context, spec BuildSpec, out io.Writer) error {
+	image := toolchainImage(spec.Toolchain, spec.Runtime)
+	if image == "" {
+		return fmt.Errorf("no build image for toolchain %q", spec.Toolchain)
+	}
+	if b.limits.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, b.limits.Timeout)
+		defer cancel()
+	}
+
+	dir, err := filepath.Abs(spec.Dir)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("hoster-build-%d", time.Now().UnixNano())
+	args := []string{
+		"run", "--rm", "--name", name,
+		"--user", fmt.Sprintf("%d:%d", os.Getuid(), os.Getgid()),
+		"--security-opt", "no-new-privileges",
+		"--cap-drop", "ALL",
+		"--pids-limit", "512",
+		"-v", dir + ":" + dir,
+		"-w", dir,
+		"-e", "HOME=/tmp",
+		"-e", "CI=true",
+	}
+	if b.limits.CPUs > 0 {
+		args = append(args, "--cpus", strconv.FormatFloat(b.limits.CPUs, 'f', -1, 64))
+	}
+	if b.limits.MemoryMB > 0 {
+		args = append(args, "--memory", fmt.Sprintf("%dm", b.limits.MemoryMB))
+	}
+	if b.limits.DiskMB > 0 {
+		// The workspace is watched while the step runs, everything else
+		// the step can write to is a tmpfs of the same size
+		args = append(args, "--read-only", "--tmpfs", fmt.Sprintf("/tmp:rw,exec,size=%dm", b.limits.DiskMB))
+	}
+	for _, mount := range spec.Mounts {
+		args = append(args, "-v", mount+":"+mount)
+	}
+	// Values are passed through the CLI's environment so that secrets
+	// don't show up in ps
+	inline, keys, env := splitRuntimeEnv(spec.Env)
+	for _, kv := range inline {
+		args = append(args, "-e", kv)
+	}
+	for _, key := range keys {
+		args = append(args, "-e", key)
+	}
+	args = append(args, image)
+	args = append(args, spec.Args...)
+
+	cmd := exec.Command(b.runtime, args...)
+	cmd.Env = env
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start build container: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	defer stopWatch()
+	exceeded := watchDiskLimit(watchCtx, dir, b.limits.DiskMB)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return err
+		}
+	case err := <-exceeded:
+		// Killing the client would leave the container running
+		exec.Command(b.runtime, "rm", "-f", name).Run()
+		<-done
+		return err
+	case <-ctx.Done():
+		exec.Command(b.runtime, "rm", "-f", name).Run()
+		<-done
+		return fmt.Errorf("build step timed out after %s", b.limits.Timeout)
+	}
+	return checkDiskLimit(spec.Dir, b.limits.DiskMB)
+}
+
+// watchDiskLimit reports on the returned channel once dir grows past
+// limitMB, checking every diskCheckInterval until ctx is done
+func watchDiskLimit(ctx context.Context, dir string, limitMB int) <-chan error {
+	exceeded := make(chan error, 1)
+	if limitMB <= 0 {
+		return exceeded
+	}
+	go func() {
+		ticker := time.NewTicker(diskCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := checkDiskLimit(dir, limitMB); err != nil {
+				exceeded <- err
+				return
+			}
+		}
+	}()
+	return exceeded
+}
+
+// checkDiskLimit fails the build when the workspace grew past limitMB
+func checkDiskLimit(dir string, limitMB int) error {
+	if limitMB <= 0 {
+		return nil
+	}
+
+	size := treeSize(dir)
+	if size > int64(limitMB)*1024*1024 {
+		return fmt.Errorf("build used %d MB of disk, limit is %d MB", size/(1024*1024), limitMB)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeContainerCLI writes a docker stand-in to dir that records the
+// arguments and environment of "run" and runs script as the container
+func fakeContainerCLI(t *testing.T, dir, script string) string {
+	t.Helper()
+	cli := filepath.Join(dir, "docker")
+	content := fmt.Sprintf(`#!/bin/sh
+if [ "$1" = rm ]; then
+	echo rm >> %[1]s/calls
+	kill $(cat %[1]s/pid)
+	exit 0
+fi
+echo $$ > %[1]s/pid
+printf '%%s\n' "$@" > %[1]s/args
+env > %[1]s/env
+%[2]s
+`, dir, script)
+	if err := os.WriteFile(cli, []byte(content), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return cli
+}
+
+func readLines(t *testing.T, path string) []string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
+
+func TestContainerBuilderKeepsSecretsOffTheCommandLine(t *testing.T) {
+	dir := t.TempDir()
+	b := &containerBuilder{runtime: fakeContainerCLI(t, dir, "exit 0")}
+	spec := BuildSpec{
+		Dir:       t.TempDir(),
+		Args:      []string{"npm", "ci"},
+		Env:       []string{"API_TOKEN=hunter2", "HTTPS_PROXY=http://proxy:3128"},
+		Toolchain: "node",
+	}
+	if err := b.Run(context.Background(), spec, io.Discard); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	args := readLines(t, filepath.Join(dir, "args"))
+	if slices.ContainsFunc(args, func(arg string) bool { return strings.Contains(arg, "hunter2") }) {
+		t.Errorf("secret on the command line: %v", args)
+	}
+	if !slices.Contains(args, "API_TOKEN") || !slices.Contains(args, "HTTPS_PROXY=http://proxy:3128") {
+		t.Errorf("args = %v, want API_TOKEN by name and the proxy inline", args)
+	}
+	env := readLines(t, filepath.Join(dir, "env"))
+	if !slices.Contains(env, "API_TOKEN=hunter2") || slices.Contains(env, "HTTPS_PROXY=http://proxy:3128") {
+		t.Errorf("CLI environment = %v, want the secret but not the proxy", env)
+	}
+}
+
+func TestContainerBuilderStopsAtDiskLimit(t *testing.T) {
+	old := diskCheckInterval
+	t.Cleanup(func() { diskCheckInterval = old })
+	diskCheckInterval = 10 * time.Millisecond
+
+	dir := t.TempDir()
+	workspace := t.TempDir()
+	script := fmt.Sprintf("head -c 2097152 /dev/zero > %s/big\nexec sleep 30", workspace)
+	b := &containerBuilder{runtime: fakeContainerCLI(t, dir, script), limits: BuildLimits{DiskMB: 1}}
+
+	start := time.Now()
+	err := b.Run(context.Background(), BuildSpec{Dir: workspace, Args: []string{"npm", "ci"}, Toolchain: "node"}, io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "limit is 1 MB") {
+		t.Fatalf("Run = %v, want the disk limit error", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("build ran for %s after crossing the limit", elapsed)
+	}
+	if calls := readLines(t, filepath.Join(dir, "calls")); !slices.Equal(calls, []string{"rm"}) {
+		t.Errorf("calls = %v, want the container removed", calls)
+	}
+}
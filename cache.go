@@ -0,0 +1,449 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache states recorded on a deployment
+const (
+	cacheHit  = "hit"
+	cacheMiss = "miss"
+)
+
+// workspaceCacheDir is where a build's dependency cache lives inside the
+// workspace, so container builds see it through the workspace mount
+const workspaceCacheDir = ".hoster-cache"
+
+// buildCache stores dependency caches (npm/pnpm/yarn/bun stores, Go
+// build cache, pip wheels) keyed by a hash of the lockfile, and build
+// artifacts keyed by the commit they were built from. Entries are evicted
+// least recently used first once the cache outgrows maxBytes.
+type buildCache struct {
+	// mu is held for reading while an entry is copied out, so it can't be
+	// replaced or evicted in the meantime
+	mu       sync.RWMutex
+	dir      string
+	maxBytes int64
+}
+
+// cacheMeta is kept next to every entry's data
+type cacheMeta struct {
+	Size     int64     `json:"size"`
+	LastUsed time.Time `json:"last_used"`
+}
+
+func newBuildCache(dir string, maxMB int) *buildCache {
+	return &buildCache{dir: dir, maxBytes: int64(maxMB) * 1024 * 1024}
+}
+
+func (c *buildCache) entryDir(key string) string {
+	return filepath.Join(c.dir, key)
+}
+
+func (c *buildCache) metaPath(key string) string {
+	return filepath.Join(c.entryDir(key), "meta.json")
+}
+
+func (c *buildCache) readMeta(key string) (cacheMeta, error) {
+	var meta cacheMeta
+	data, err := os.ReadFile(c.metaPath(key))
+	if err != nil {
+		return meta, err
+	}
+	return meta, json.Unmarshal(data, &meta)
+}
+
+func (c *buildCache) writeMeta(key string, meta cacheMeta) error {
+	data, _ := json.MarshalIndent(meta, "", "  ")
+	return os.WriteFile(c.metaPath(key), data, 0644)
+}
+
+// restore copies the entry for key into target and reports whether there
+// was one
+func (c *buildCache) restore(key, target string) (bool, error) {
+	c.mu.Lock()
+	meta, err := c.readMeta(key)
+	if err == nil {
+		meta.LastUsed = time.Now()
+		c.writeMeta(key, meta)
+	}
+	c.mu.Unlock()
+	if err != nil {
+		return false, nil
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if err := copyTree(filepath.Join(c.entryDir(key), "data"), target); err != nil {
+		return false, fmt.Errorf("failed to restore cache: %v", err)
+	}
+	return true, nil
+}
+
+// save stores source as the entry for key and evicts old entries. An
+// entry saved by a concurrent build in the meantime is kept unless replace
+// is set.
+func (c *buildCache) save(key, source string, replace bool) error {
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return err
+	}
+	tmp, err := os.MkdirTemp(c.dir, key+".partial-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := copyTree(source, filepath.Join(tmp, "data")); err != nil {
+		return fmt.Errorf("failed to save cache: %v", err)
+	}
+	meta := cacheMeta{Size: treeSize(tmp), LastUsed: time.Now()}
+	data, _ := json.MarshalIndent(meta, "", "  ")
+	if err := os.WriteFile(filepath.Join(tmp, "meta.json"), data, 0644); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := os.Stat(c.entryDir(key)); err == nil {
+		if !replace {
+			return nil
+		}
+		if err := os.RemoveAll(c.entryDir(key)); err != nil {
+			return fmt.Errorf("failed to replace cache: %v", err)
+		}
+	}
+	if err := os.Rename(tmp, c.entryDir(key)); err != nil {
+		return fmt.Errorf("failed to save cache: %v", err)
+	}
+	c.evict()
+	return nil
+}
+
+// evict removes least recently used entries until the cache fits. It must
+// be called with c.mu held.
+func (c *buildCache) evict() {
+	if c.maxBytes <= 0 {
+		return
+	}
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+
+	type entry struct {
+		key  string
+		meta cacheMeta
+	}
+	var all []entry
+	var total int64
+	for _, e := range entries {
+		meta, err := c.readMeta(e.Name())
+		if err != nil {
+			continue
+		}
+		all = append(all, entry{e.Name(), meta})
+		total += meta.Size
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].meta.LastUsed.Before(all[j].meta.LastUsed) })
+
+	for _, e := range all {
+		if total <= c.maxBytes {
+			break
+		}
+		if err := os.RemoveAll(c.entryDir(e.key)); err != nil {
+			fmt.Printf("Warning: Failed to evict cache entry %s: %v\n", e.key, err)
+			continue
+		}
+		fmt.Printf("Evicted build cache entry %s (%d MB)\n", e.key, e.meta.Size/(1024*1024))
+		total -= e.meta.Size
+	}
+}
+
+// cacheSpec says what to cache for a project: the lockfile the key is
+// derived from and the variable pointing the toolchain at its cache
+type cacheSpec struct {
+	lockfile string
+	envVar   string
+}
+
+// cacheSpecFor returns the cache spec of the project in dir, false if the
+// project has no lockfile to key the cache on
+func (j *deployJob) cacheSpecFor(dir, projectType string) (cacheSpec, bool) {
+	var specs []cacheSpec
+	switch projectType {
+	case "node":
+		switch j.preset.PackageManager {
+		case "pnpm":
+			specs = []cacheSpec{{lockfile: "pnpm-lock.yaml", envVar: "npm_config_store_dir"}}
+		case "yarn":
+			specs = []cacheSpec{{lockfile: "yarn.lock", envVar: "YARN_CACHE_FOLDER"}}
+		case "bun":
+			specs = []cacheSpec{
+				{lockfile: "bun.lockb", envVar: "BUN_INSTALL_CACHE_DIR"},
+				{lockfile: "bun.lock", envVar: "BUN_INSTALL_CACHE_DIR"},
+			}
+		default:
+			specs = []cacheSpec{
+				{lockfile: "package-lock.json", envVar: "npm_config_cache"},
+				{lockfile: "npm-shrinkwrap.json", envVar: "npm_config_cache"},
+			}
+		}
+	case "go":
+		// Modules live in the project's GOMODCACHE, this caches compiled packages
+		specs = []cacheSpec{{lockfile: "go.sum", envVar: "GOCACHE"}}
+	case "python":
+		installer := detectPythonInstaller(dir)
+		specs = []cacheSpec{{lockfile: installer.Lockfile, envVar: installer.CacheEnv}}
+	}
+
+	for _, spec := range specs {
+		if _, err := os.Stat(filepath.Join(dir, spec.lockfile)); err == nil {
+			return spec, true
+		}
+	}
+	return cacheSpec{}, false
+}
+
+// cacheKey hashes everything that changes what gets installed. Caches are
+// saved after the project's own install scripts ran, so they are never
+// shared between projects or owners.
+func (j *deployJob) cacheKey(dir string, spec cacheSpec) (string, error) {
+	lockfile, err := os.ReadFile(filepath.Join(dir, spec.lockfile))
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n", j.Owner, j.Project)
+	fmt.Fprintf(h, "%s\n%s\n%s\n", spec.envVar, j.preset.PackageManager, j.nodeVersion)
+	if j.manifest != nil {
+		fmt.Fprintf(h, "%s\n", j.manifest.Runtime)
+	}
+	h.Write(lockfile)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// projectCacheID names the caches that belong to a single project
+func projectCacheID(owner, project string) string {
+	sum := sha256.Sum256([]byte(owner + "\n" + project))
+	return hex.EncodeToString(sum[:16])
+}
+
+// withBuildCache runs install with the project's dependency cache restored
+// into the workspace. The cache is saved afterwards if it was a miss, and
+// refreshed if install changed it, e.g. Go compiled new packages.
+func (j *deployJob) withBuildCache(dir, projectType string, install func() error) error {
+	spec, ok := j.cacheSpecFor(dir, projectType)
+	if !ok || buildCaches == nil || j.Fork {
+		return install()
+	}
+	key, err := j.cacheKey(dir, spec)
+	if err != nil {
+		return install()
+	}
+
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	cacheDir := filepath.Join(absDir, workspaceCacheDir, spec.envVar)
+
+	hit, err := buildCaches.restore(key, cacheDir)
+	if err != nil {
+		j.log.Printf("Warning: %v\n", err)
+	}
+	var before string
+	if hit {
+		j.log.Printf("Build cache hit for %s\n", spec.lockfile)
+		j.setCacheStatus(cacheHit)
+		before = treeFingerprint(cacheDir)
+	} else {
+		j.log.Printf("Build cache miss for %s\n", spec.lockfile)
+		j.setCacheStatus(cacheMiss)
+		os.MkdirAll(cacheDir, 0755)
+	}
+
+	env := j.env
+	j.env = append(append([]string{}, env...), spec.envVar+"="+cacheDir)
+	err = install()
+	j.env = env
+	if err != nil {
+		return err
+	}
+
+	if !hit {
+		if err := buildCaches.save(key, cacheDir, false); err != nil {
+			j.log.Printf("Warning: %v\n", err)
+		}
+	} else if treeFingerprint(cacheDir) != before {
+		j.log.Printf("Refreshing build cache for %s\n", spec.lockfile)
+		if err := buildCaches.save(key, cacheDir, true); err != nil {
+			j.log.Printf("Warning: %v\n", err)
+		}
+	}
+	return nil
+}
+
+// artifactKey hashes the commit and everything else that goes into a
+// build's output. Variables are part of it as builds may inline them.
+func (j *deployJob) artifactKey(inputs ...string) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "artifact\n%s\n%s\n%s\n", j.Owner, j.Project, j.commitSHA)
+	fmt.Fprintf(h, "%T\n%s\n", builder, j.nodeVersion)
+	if j.manifest != nil {
+		fmt.Fprintf(h, "%s\n", j.manifest.Runtime)
+	}
+	env := slices.Clone(j.env)
+	sort.Strings(env)
+	fmt.Fprintf(h, "%s\n%s\n", strings.Join(env, "\x00"), strings.Join(inputs, "\x00"))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// withArtifactCache restores target, a build's output, from an earlier
+// build of the same commit and inputs instead of running build. Otherwise
+// target is saved once build succeeds.
+func (j *deployJob) withArtifactCache(target string, inputs []string, build func() error) error {
+	j.mu.RLock()
+	commit := j.commitSHA
+	j.mu.RUnlock()
+	if buildCaches == nil || j.Fork || commit == "" {
+		return build()
+	}
+	key := j.artifactKey(inputs...)
+
+	hit, err := buildCaches.restore(key, target)
+	if err != nil {
+		j.log.Printf("Warning: %v\n", err)
+	}
+	if hit {
+		j.log.Printf("Build artifact cache hit for %s, skipping the build\n", commit)
+		j.setCacheStatus(cacheHit)
+		return nil
+	}
+	if err := build(); err != nil {
+		return err
+	}
+	if err := buildCaches.save(key, target, false); err != nil {
+		j.log.Printf("Warning: %v\n", err)
+	}
+	return nil
+}
+
+// staticOutput is the declared output directory of a static build, empty
+// if the build has none or runs a server
+func (j *deployJob) staticOutput() string {
+	if j.manifest.Start != "" || len(j.preset.Start) > 0 {
+		return ""
+	}
+	output := j.manifest.Output
+	if output == "" {
+		output = j.preset.Output
+	}
+	output = filepath.Clean(output)
+	if output == "." || !filepath.IsLocal(output) {
+		return ""
+	}
+	return output
+}
+
+// staticBuildInputs are the commands building output
+func (j *deployJob) staticBuildInputs(output string) []string {
+	return []string{
+		j.toolchain(), output, j.manifest.Install, j.manifest.Build,
+		strings.Join(j.preset.Install, " "), strings.Join(j.preset.Build, " "),
+	}
+}
+
+// treeFingerprint changes whenever a file under dir is added, removed or
+// modified
+func treeFingerprint(dir string) string {
+	h := sha256.New()
+	filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		rel, _ := filepath.Rel(dir, path)
+		fmt.Fprintf(h, "%s\x00%d\x00%d\x00%s\n", rel, info.Size(), info.ModTime().UnixNano(), info.Mode())
+		return nil
+	})
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// copyTree copies a directory keeping file modes and symlinks
+func copyTree(source, target string) error {
+	return filepath.WalkDir(source, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		dest := filepath.Join(target, rel)
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(dest, info.Mode().Perm()|0700)
+		case info.Mode()&fs.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, dest)
+		case info.Mode().IsRegular():
+			return copyFile(path, dest, info.Mode().Perm())
+		}
+		return nil
+	})
+}
+
+func copyFile(source, target string, perm fs.FileMode) error {
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm|0200)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// treeSize returns the total size of the files under dir
+func treeSize(dir string) int64 {
+	var size int64
+	filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		if info, err := d.Info(); err == nil {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func setupBuildCache(t *testing.T) {
+	t.Helper()
+	oldCaches, oldLogDir := buildCaches, deploymentLogDir
+	t.Cleanup(func() { buildCaches, deploymentLogDir = oldCaches, oldLogDir })
+	buildCaches = newBuildCache(t.TempDir(), 0)
+	deploymentLogDir = t.TempDir()
+}
+
+func TestBuildCacheRefreshedWhenInstallChangesIt(t *testing.T) {
+	setupBuildCache(t)
+	files := map[string]string{"go.mod": "module example.com/app\n", "go.sum": "example.com/dep v1.0.0 h1:abc\n"}
+
+	// Each build compiles one more package into GOCACHE
+	build := func(pkg string) []string {
+		t.Helper()
+		repo := writeRepo(t, files)
+		job := newDeployJob("octo-org", "api", "main", "")
+		var found []string
+		err := job.withBuildCache(repo, "go", func() error {
+			cacheDir := filepath.Join(repo, workspaceCacheDir, "GOCACHE")
+			entries, _ := os.ReadDir(cacheDir)
+			for _, e := range entries {
+				found = append(found, e.Name())
+			}
+			return os.WriteFile(filepath.Join(cacheDir, pkg), []byte(pkg), 0644)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return found
+	}
+
+	build("a")
+	if found := build("b"); len(found) != 1 || found[0] != "a" {
+		t.Fatalf("second build found %v, want the first build's cache", found)
+	}
+	if found := build("b"); len(found) != 2 {
+		t.Errorf("third build found %v, want the cache refreshed by the second", found)
+	}
+}
+
+func TestArtifactCache(t *testing.T) {
+	setupBuildCache(t)
+
+	deploy := func(commit string, env ...string) (bool, string) {
+		t.Helper()
+		target := filepath.Join(t.TempDir(), "dist")
+		job := newDeployJob("octo-org", "site", "main", "")
+		job.setCommitSHA(commit)
+		job.env = env
+		built := false
+		err := job.withArtifactCache(target, []string{"npm run build"}, func() error {
+			built = true
+			os.MkdirAll(target, 0755)
+			return os.WriteFile(filepath.Join(target, "index.html"), []byte(commit), 0644)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := os.ReadFile(filepath.Join(target, "index.html"))
+		return built, string(data)
+	}
+
+	if built, _ := deploy("abc123", "API_URL=https://a.example.com"); !built {
+		t.Fatal("first build of a commit was skipped")
+	}
+	if built, index := deploy("abc123", "API_URL=https://a.example.com"); built || index != "abc123" {
+		t.Errorf("rebuild of the same commit built = %v with %q, want the cached output", built, index)
+	}
+	if built, _ := deploy("abc123", "API_URL=https://b.example.com"); !built {
+		t.Error("build with changed variables used the cached output")
+	}
+	if built, _ := deploy("def456", "API_URL=https://a.example.com"); !built {
+		t.Error("build of another commit used the cached output")
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/RajBhut/go-basics/caddy"
+)
+
+var (
+	caddyConfigFile = "caddy_config.json"
+	caddyAdminURL   = "http://localhost:2019"
+	caddyAdmin      = caddy.NewClient(caddyAdminURL)
+
+	// caddyMu serializes route changes so the legacy cleanup and the
+	// snapshot see a consistent config
+	caddyMu sync.Mutex
+)
+
+// projectHost is the hostname a site is served on through Caddy
+func projectHost(name string) string {
+	return name + ".hoster.localhost"
+}
+
+// routeID is the @id of the route serving host
+func routeID(host string) string {
+	return "hoster-" + strings.ToLower(host)
+}
+
+// saveCaddySnapshot writes the running config to caddy_config.json so
+// routes survive a restart of Caddy
+func saveCaddySnapshot() {
+	config, err := caddyAdmin.Config()
+	if err != nil {
+		fmt.Printf("Warning: Failed to read Caddy config: %v\n", err)
+		return
+	}
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, config, "", "  "); err != nil {
+		return
+	}
+	if err := os.WriteFile(caddyConfigFile, pretty.Bytes(), 0644); err != nil {
+		fmt.Printf("Warning: Failed to update Caddy config file: %v\n", err)
+	}
+}
+
+// staticSiteRoute serves root on host and the site's custom domains. With
+// spa set, unknown paths fall back to index.html for client side routing.
+func staticSiteRoute(host, root string, spa bool) caddy.Route {
+	// FIRST: Try to serve the actual files
+	routes := []caddy.Route{
+		// Ensure forward slashes for Caddy
+		{Handle: []caddy.Handler{caddy.FileServer(filepath.ToSlash(root))}},
+	}
+	if spa {
+		// SECOND: Only if file not found, fall back to index.html
+		routes = append(routes, caddy.Route{
+			Match: []caddy.Match{
+				{Not: []caddy.Match{{File: &caddy.FileMatch{TryFiles: []string{"{http.request.uri.path}"}}}}},
+			},
+			Handle: []caddy.Handler{caddy.Rewrite("/index.html")},
+		})
+	}
+
+	return caddy.Route{
+		Match:  []caddy.Match{{Host: routeHosts(host)}},
+		Handle: []caddy.Handler{caddy.Subroute(routes...)},
+	}
+}
+
+// proxyRoute forwards every request for host and the site's custom domains
+// to a backend app
+func proxyRoute(host, upstream string) caddy.Route {
+	return caddy.Route{
+		Match:  []caddy.Match{{Host: routeHosts(host)}},
+		Handle: []caddy.Handler{caddy.ReverseProxy(upstream)},
+	}
+}
+
+// setCaddyRoute replaces whatever currently serves host with route. Caddy
+// swaps the route atomically.
+func setCaddyRoute(host string, route caddy.Route) error {
+	caddyMu.Lock()
+	defer caddyMu.Unlock()
+
+	route.ID = routeID(host)
+	if err := caddyAdmin.UpsertRoute(route); err != nil {
+		return fmt.Errorf("failed to update Caddy route: %v", err)
+	}
+	if err := removeLegacyRoutes(host); err != nil {
+		return err
+	}
+	saveCaddySnapshot()
+	return nil
+}
+
+// registerCaddyRoute serves the static site at root on host
+func registerCaddyRoute(host, root string, spa bool) error {
+	return setCaddyRoute(host, staticSiteRoute(host, root, spa))
+}
+
+// removeLegacyRoutes drops routes for host added before routes had an
+// @id. It must be called with caddyMu held.
+func removeLegacyRoutes(host string) error {
+	routes, err := caddyAdmin.Routes()
+	if err != nil {
+		return fmt.Errorf("failed to read Caddy routes: %v", err)
+	}
+	// Back to front so the remaining indexes stay valid
+	for i := len(routes) - 1; i >= 0; i-- {
+		if routes[i].ID == "" && routes[i].MatchesHost(host) {
+			if err := caddyAdmin.RemoveRouteAt(i); err != nil {
+				return fmt.Errorf("failed to remove Caddy route: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+func caddyRouteExists(host string) (bool, error) {
+	caddyMu.Lock()
+	defer caddyMu.Unlock()
+
+	routes, err := caddyAdmin.Routes()
+	if err != nil {
+		return false, fmt.Errorf("failed to read Caddy routes: %v", err)
+	}
+	for _, route := range routes {
+		if route.ID == routeID(host) || route.MatchesHost(host) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// removeCaddyRoute drops every route serving host
+func removeCaddyRoute(host string) error {
+	caddyMu.Lock()
+	defer caddyMu.Unlock()
+
+	if err := caddyAdmin.RemoveRoute(routeID(host)); err != nil {
+		return fmt.Errorf("failed to remove Caddy route: %v", err)
+	}
+	if err := removeLegacyRoutes(host); err != nil {
+		return err
+	}
+	saveCaddySnapshot()
+	return nil
+}
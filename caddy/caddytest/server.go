@@ -0,0 +1,287 @@
+// Package caddytest provides an in-memory fake of the Caddy admin API for
+// tests. It implements /load, /config/ and /id/ with Caddy's semantics for
+// GET, POST, PUT, PATCH and DELETE on JSON paths.
+package caddytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Server is a fake Caddy admin endpoint
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	config   interface{}
+	requests []string
+}
+
+// NewServer starts a fake admin API with config loaded, which may be nil
+func NewServer(config []byte) (*Server, error) {
+	s := &Server{}
+	if len(config) > 0 {
+		if err := json.Unmarshal(config, &s.config); err != nil {
+			return nil, fmt.Errorf("invalid config: %v", err)
+		}
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s, nil
+}
+
+// Config returns the current configuration
+func (s *Server) Config() []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, _ := json.Marshal(s.config)
+	return data
+}
+
+// Requests returns every request received so far as "METHOD path"
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.requests...)
+}
+
+// Writes returns the requests that changed the configuration
+func (s *Server) Writes() []string {
+	var writes []string
+	for _, r := range s.Requests() {
+		if !strings.HasPrefix(r, http.MethodGet+" ") {
+			writes = append(writes, r)
+		}
+	}
+	return writes
+}
+
+type apiError struct {
+	status int
+	err    error
+}
+
+func errorf(status int, format string, args ...interface{}) *apiError {
+	return &apiError{status, fmt.Errorf(format, args...)}
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+
+	var body interface{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch {
+		data, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(data, &body); err != nil {
+			writeError(w, errorf(http.StatusBadRequest, "decoding request: %v", err))
+			return
+		}
+	}
+
+	var path []string
+	switch {
+	case r.URL.Path == "/load" && r.Method == http.MethodPost:
+		s.config = body
+		return
+	case strings.HasPrefix(r.URL.Path, "/config/"):
+		path = splitPath(strings.TrimPrefix(r.URL.Path, "/config/"))
+	case strings.HasPrefix(r.URL.Path, "/id/"):
+		parts := splitPath(strings.TrimPrefix(r.URL.Path, "/id/"))
+		if len(parts) == 0 {
+			writeError(w, errorf(http.StatusBadRequest, "missing ID"))
+			return
+		}
+		found, ok := findID(s.config, parts[0], nil)
+		if !ok {
+			writeError(w, errorf(http.StatusNotFound, "unknown object ID '%s'", parts[0]))
+			return
+		}
+		path = append(found, parts[1:]...)
+	default:
+		writeError(w, errorf(http.StatusNotFound, "not found"))
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		value, err := get(s.config, path)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(value)
+		return
+	}
+
+	config, err := modify(s.config, path, r.Method, body)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.config = config
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(err.status)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.err.Error()})
+}
+
+func splitPath(path string) []string {
+	var parts []string
+	for _, part := range strings.Split(path, "/") {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
+
+// findID returns the path of the object with the given @id
+func findID(value interface{}, id string, path []string) ([]string, bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if v["@id"] == id {
+			return path, true
+		}
+		for key, child := range v {
+			if found, ok := findID(child, id, append(append([]string{}, path...), key)); ok {
+				return found, true
+			}
+		}
+	case []interface{}:
+		for i, child := range v {
+			if found, ok := findID(child, id, append(append([]string{}, path...), strconv.Itoa(i))); ok {
+				return found, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// get returns the value at path. Like Caddy, a missing last key is null
+// while a missing intermediate one is an error.
+func get(value interface{}, path []string) (interface{}, *apiError) {
+	for i, part := range path {
+		last := i == len(path)-1
+		switch v := value.(type) {
+		case map[string]interface{}:
+			child, ok := v[part]
+			if !ok && !last {
+				return nil, errorf(http.StatusBadRequest, "invalid traversal path at: %s", strings.Join(path[:i+1], "/"))
+			}
+			value = child
+		case []interface{}:
+			idx, err := strconv.Atoi(part)
+			if err != nil || idx < 0 || idx >= len(v) {
+				return nil, errorf(http.StatusBadRequest, "invalid index: %s", part)
+			}
+			value = v[idx]
+		default:
+			if value == nil && last {
+				return nil, nil
+			}
+			return nil, errorf(http.StatusBadRequest, "invalid traversal path at: %s", strings.Join(path[:i+1], "/"))
+		}
+	}
+	return value, nil
+}
+
+// modify applies a write to the value at path and returns the new root
+func modify(root interface{}, path []string, method string, body interface{}) (interface{}, *apiError) {
+	if len(path) == 0 {
+		switch method {
+		case http.MethodDelete:
+			return nil, nil
+		case http.MethodPost, http.MethodPatch:
+			return body, nil
+		case http.MethodPut:
+			if root != nil {
+				return nil, errorf(http.StatusConflict, "config already exists")
+			}
+			return body, nil
+		}
+		return nil, errorf(http.StatusMethodNotAllowed, "method not allowed")
+	}
+
+	part, rest := path[0], path[1:]
+	switch v := root.(type) {
+	case map[string]interface{}:
+		child, exists := v[part]
+		if len(rest) > 0 {
+			if !exists {
+				return nil, errorf(http.StatusBadRequest, "invalid traversal path at: %s", part)
+			}
+			updated, err := modify(child, rest, method, body)
+			if err != nil {
+				return nil, err
+			}
+			v[part] = updated
+			return v, nil
+		}
+		switch method {
+		case http.MethodPost:
+			// Appends to a list, sets or replaces anything else
+			if list, ok := child.([]interface{}); ok {
+				v[part] = append(list, body)
+			} else {
+				v[part] = body
+			}
+		case http.MethodPut:
+			if exists {
+				return nil, errorf(http.StatusConflict, "key already exists: %s", part)
+			}
+			v[part] = body
+		case http.MethodPatch:
+			if !exists {
+				return nil, errorf(http.StatusNotFound, "key does not exist: %s", part)
+			}
+			v[part] = body
+		case http.MethodDelete:
+			if !exists {
+				return nil, errorf(http.StatusNotFound, "key does not exist: %s", part)
+			}
+			delete(v, part)
+		default:
+			return nil, errorf(http.StatusMethodNotAllowed, "method not allowed")
+		}
+		return v, nil
+
+	case []interface{}:
+		idx, err := strconv.Atoi(part)
+		if err != nil || idx < 0 || idx > len(v) || (idx == len(v) && method != http.MethodPut) {
+			return nil, errorf(http.StatusBadRequest, "invalid index: %s", part)
+		}
+		if len(rest) > 0 {
+			updated, err := modify(v[idx], rest, method, body)
+			if err != nil {
+				return nil, err
+			}
+			v[idx] = updated
+			return v, nil
+		}
+		switch method {
+		case http.MethodPut:
+			// Inserts before idx
+			v = append(v[:idx], append([]interface{}{body}, v[idx:]...)...)
+		case http.MethodPatch:
+			v[idx] = body
+		case http.MethodDelete:
+			v = append(v[:idx], v[idx+1:]...)
+		default:
+			return nil, errorf(http.StatusMethodNotAllowed, "method not allowed")
+		}
+		return v, nil
+	}
+
+	if root == nil && len(rest) == 0 && (method == http.MethodPost || method == http.MethodPut) {
+		return map[string]interface{}{part: body}, nil
+	}
+	return nil, errorf(http.StatusBadRequest, "invalid traversal path at: %s", part)
+}
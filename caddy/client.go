@@ -0,0 +1,209 @@
+package caddy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Error is a non-2xx answer of the admin API
+type Error struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("caddy %s %s: %d %s", e.Method, e.Path, e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is the admin API's answer for an unknown
+// @id
+func IsNotFound(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// Client talks to the admin API of a Caddy instance. Routes are managed in
+// the routes list of a single HTTP server.
+type Client struct {
+	URL    string
+	Server string
+	HTTP   *http.Client
+}
+
+// NewClient returns a client for the admin API at adminURL managing the
+// routes of srv0
+func NewClient(adminURL string) *Client {
+	return &Client{
+		URL:    strings.TrimRight(adminURL, "/"),
+		Server: "srv0",
+		HTTP:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (c *Client) serverPath() string {
+	return "/config/apps/http/servers/" + url.PathEscape(c.Server)
+}
+
+func (c *Client) routesPath() string {
+	return c.serverPath() + "/routes"
+}
+
+func idPath(id string) string {
+	return "/id/" + url.PathEscape(id)
+}
+
+// do sends body as JSON and decodes the answer into out unless it is nil
+func (c *Client) do(method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, c.URL+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return fmt.Errorf("caddy %s %s failed: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		// Caddy answers errors as {"error": "..."}
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		message := strings.TrimSpace(string(data))
+		if json.Unmarshal(data, &apiErr) == nil && apiErr.Error != "" {
+			message = apiErr.Error
+		}
+		return &Error{Method: method, Path: path, StatusCode: resp.StatusCode, Message: message}
+	}
+	if out != nil && len(bytes.TrimSpace(data)) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return fmt.Errorf("failed to decode caddy %s %s: %v", method, path, err)
+		}
+	}
+	return nil
+}
+
+// Config returns the running configuration
+func (c *Client) Config() (json.RawMessage, error) {
+	var config json.RawMessage
+	err := c.do(http.MethodGet, "/config/", nil, &config)
+	return config, err
+}
+
+// Load replaces the running configuration
+func (c *Client) Load(config json.RawMessage) error {
+	return c.do(http.MethodPost, "/load", config, nil)
+}
+
+// Routes returns the server's routes in order
+func (c *Client) Routes() ([]Route, error) {
+	var routes []Route
+	err := c.do(http.MethodGet, c.routesPath(), nil, &routes)
+	return routes, err
+}
+
+// Route returns the route with the given @id, nil if there is none
+func (c *Client) Route(id string) (*Route, error) {
+	var route Route
+	err := c.do(http.MethodGet, idPath(id), nil, &route)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &route, nil
+}
+
+// UpsertRoute replaces the route with route.ID in place, or inserts route
+// at the front of the server's routes, ahead of any catch-all, if there is
+// none. Either way Caddy switches to the new route atomically.
+func (c *Client) UpsertRoute(route Route) error {
+	if route.ID == "" {
+		return fmt.Errorf("route has no @id")
+	}
+	existing, err := c.exists(idPath(route.ID))
+	if err != nil {
+		return err
+	}
+	if existing {
+		return c.do(http.MethodPatch, idPath(route.ID), route, nil)
+	}
+	return c.insertFront(c.routesPath(), route)
+}
+
+// exists reports whether there is a value at path
+func (c *Client) exists(path string) (bool, error) {
+	var raw json.RawMessage
+	err := c.do(http.MethodGet, path, nil, &raw)
+	if IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil && !isNull(raw), err
+}
+
+// insertFront inserts value at the start of the list at path, creating the
+// list if there is none
+func (c *Client) insertFront(path string, value interface{}) error {
+	var list []json.RawMessage
+	if err := c.do(http.MethodGet, path, nil, &list); err != nil {
+		return err
+	}
+	switch {
+	case list == nil:
+		// PUT fails on a key that is there but null, POST sets it either way
+		return c.do(http.MethodPost, path, []interface{}{value}, nil)
+	case len(list) == 0:
+		return c.do(http.MethodPost, path, value, nil)
+	}
+	// PUT on an index inserts before it
+	return c.do(http.MethodPut, path+"/0", value, nil)
+}
+
+func isNull(raw json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(raw)
+	return len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null"))
+}
+
+// RemoveRoute deletes the route with the given @id. Removing a route that
+// does not exist is not an error.
+func (c *Client) RemoveRoute(id string) error {
+	return c.removeID(id)
+}
+
+func (c *Client) removeID(id string) error {
+	err := c.do(http.MethodDelete, idPath(id), nil, nil)
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// RemoveRouteAt deletes the route at index i, for routes without an @id
+func (c *Client) RemoveRouteAt(i int) error {
+	return c.do(http.MethodDelete, fmt.Sprintf("%s/%d", c.routesPath(), i), nil, nil)
+}
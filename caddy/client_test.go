@@ -0,0 +1,229 @@
+package caddy_test
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/RajBhut/go-basics/caddy"
+	"github.com/RajBhut/go-basics/caddy/caddytest"
+)
+
+// catchAll stands in for a route Hoster didn't create, e.g. the fallback
+// of a hand-written Caddyfile
+const catchAll = `{"handle": [{"handler": "static_response", "body": "fallback"}]}`
+
+func newClient(t *testing.T, config string) (*caddy.Client, *caddytest.Server) {
+	t.Helper()
+	fake, err := caddytest.NewServer([]byte(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(fake.Close)
+	return caddy.NewClient(fake.URL), fake
+}
+
+func hostRoute(host, dial string) caddy.Route {
+	return caddy.Route{
+		ID:       "hoster-" + host,
+		Match:    []caddy.Match{{Host: []string{host}}},
+		Handle:   []caddy.Handler{caddy.ReverseProxy(dial)},
+		Terminal: true,
+	}
+}
+
+func routeIDs(t *testing.T, c *caddy.Client) []string {
+	t.Helper()
+	routes, err := c.Routes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := []string{}
+	for _, r := range routes {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
+
+func TestUpsertRouteInsertsAtFront(t *testing.T) {
+	tests := []struct {
+		name   string
+		routes string
+		write  string
+		want   []string
+	}{
+		{"missing list", ``, "POST /config/apps/http/servers/srv0/routes", []string{"hoster-a.localhost"}},
+		{"null list", `null`, "POST /config/apps/http/servers/srv0/routes", []string{"hoster-a.localhost"}},
+		{"empty list", `[]`, "POST /config/apps/http/servers/srv0/routes", []string{"hoster-a.localhost"}},
+		{"ahead of catch-all", `[` + catchAll + `]`, "PUT /config/apps/http/servers/srv0/routes/0", []string{"hoster-a.localhost", ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := `{"listen": [":80"]}`
+			if tt.routes != "" {
+				server = `{"listen": [":80"], "routes": ` + tt.routes + `}`
+			}
+			c, fake := newClient(t, `{"apps": {"http": {"servers": {"srv0": `+server+`}}}}`)
+			if err := c.UpsertRoute(hostRoute("a.localhost", "localhost:9000")); err != nil {
+				t.Fatalf("UpsertRoute: %v", err)
+			}
+			if writes := fake.Writes(); !slices.Equal(writes, []string{tt.write}) {
+				t.Errorf("writes = %v, want %s", writes, tt.write)
+			}
+			if ids := routeIDs(t, c); !slices.Equal(ids, tt.want) {
+				t.Errorf("routes = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpsertRouteReplacesInPlace(t *testing.T) {
+	c, fake := newClient(t, `{"apps": {"http": {"servers": {"srv0": {"routes": [`+catchAll+`]}}}}}`)
+	for _, host := range []string{"a.localhost", "b.localhost"} {
+		if err := c.UpsertRoute(hostRoute(host, "localhost:9000")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	before := len(fake.Writes())
+	if err := c.UpsertRoute(hostRoute("a.localhost", "localhost:9001")); err != nil {
+		t.Fatalf("UpsertRoute: %v", err)
+	}
+	if writes := fake.Writes()[before:]; !slices.Equal(writes, []string{"PATCH /id/hoster-a.localhost"}) {
+		t.Errorf("writes = %v, want a single PATCH of the route", writes)
+	}
+	if ids := routeIDs(t, c); !slices.Equal(ids, []string{"hoster-b.localhost", "hoster-a.localhost", ""}) {
+		t.Errorf("routes = %v, want the order unchanged", ids)
+	}
+
+	route, err := c.Route("hoster-a.localhost")
+	if err != nil || route == nil {
+		t.Fatalf("Route = %v, %v", route, err)
+	}
+	if dial := route.Handle[0].Upstreams[0].Dial; dial != "localhost:9001" {
+		t.Errorf("upstream = %s, want localhost:9001", dial)
+	}
+}
+
+func TestUpsertRouteWithoutID(t *testing.T) {
+	c, fake := newClient(t, `{}`)
+	if err := c.UpsertRoute(caddy.Route{}); err == nil {
+		t.Error("UpsertRoute accepted a route without @id")
+	}
+	if requests := fake.Requests(); len(requests) != 0 {
+		t.Errorf("requests = %v, want none", requests)
+	}
+}
+
+func TestRemoveRoute(t *testing.T) {
+	c, _ := newClient(t, `{"apps": {"http": {"servers": {"srv0": {"routes": [`+catchAll+`]}}}}}`)
+	if err := c.UpsertRoute(hostRoute("a.localhost", "localhost:9000")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.RemoveRoute("hoster-a.localhost"); err != nil {
+		t.Fatalf("RemoveRoute: %v", err)
+	}
+	if ids := routeIDs(t, c); !slices.Equal(ids, []string{""}) {
+		t.Errorf("routes = %v, want only the catch-all", ids)
+	}
+	if err := c.RemoveRoute("hoster-a.localhost"); err != nil {
+		t.Errorf("RemoveRoute of a missing route = %v, want nil", err)
+	}
+	route, err := c.Route("hoster-a.localhost")
+	if route != nil || err != nil {
+		t.Errorf("Route = %v, %v, want nil, nil", route, err)
+	}
+}
+
+func TestUpsertPolicyCreatesTLSApp(t *testing.T) {
+	c, fake := newClient(t, `{"apps": {"http": {"servers": {"srv0": {}}}, "tls": null}}`)
+	policy := caddy.AutomationPolicy{ID: "hoster-tls-a.example.com", Subjects: []string{"a.example.com"}, Issuers: []caddy.Issuer{caddy.InternalIssuer()}}
+	if err := c.UpsertPolicy(policy); err != nil {
+		t.Fatalf("UpsertPolicy: %v", err)
+	}
+	other := caddy.AutomationPolicy{ID: "hoster-tls-b.example.com", Subjects: []string{"b.example.com"}}
+	if err := c.UpsertPolicy(other); err != nil {
+		t.Fatalf("UpsertPolicy: %v", err)
+	}
+	policy.Issuers = []caddy.Issuer{caddy.ACMEIssuer("https://localhost:14000/dir", "", "")}
+	if err := c.UpsertPolicy(policy); err != nil {
+		t.Fatalf("UpsertPolicy: %v", err)
+	}
+	want := []string{
+		"POST /config/apps/tls",
+		"PUT /config/apps/tls/automation/policies/0",
+		"PATCH /id/hoster-tls-a.example.com",
+	}
+	if writes := fake.Writes(); !slices.Equal(writes, want) {
+		t.Errorf("writes = %v, want %v", writes, want)
+	}
+
+	var config caddy.Config
+	if err := json.Unmarshal(fake.Config(), &config); err != nil {
+		t.Fatal(err)
+	}
+	policies := config.Policies()
+	if len(policies) != 2 || policies[0].ID != other.ID || policies[1].Issuers[0].Module != "acme" {
+		t.Errorf("policies = %+v", policies)
+	}
+
+	if err := c.RemovePolicy("hoster-tls-missing"); err != nil {
+		t.Errorf("RemovePolicy of a missing policy = %v, want nil", err)
+	}
+}
+
+func TestUpsertPolicyCreatesAutomation(t *testing.T) {
+	c, fake := newClient(t, `{"apps": {"tls": {"certificates": {}}}}`)
+	policy := caddy.AutomationPolicy{ID: "hoster-tls-a.example.com", Subjects: []string{"a.example.com"}}
+	if err := c.UpsertPolicy(policy); err != nil {
+		t.Fatalf("UpsertPolicy: %v", err)
+	}
+	if writes := fake.Writes(); !slices.Equal(writes, []string{"POST /config/apps/tls/automation"}) {
+		t.Errorf("writes = %v, want the automation created with the policy", writes)
+	}
+	var config caddy.Config
+	if err := json.Unmarshal(fake.Config(), &config); err != nil {
+		t.Fatal(err)
+	}
+	if policies := config.Policies(); len(policies) != 1 || policies[0].ID != policy.ID {
+		t.Errorf("policies = %+v", policies)
+	}
+}
+
+func TestSetAutomaticHTTPS(t *testing.T) {
+	c, fake := newClient(t, `{"apps": {"http": {"servers": {"srv0": {"listen": [":80"]}}}}}`)
+	if err := c.SetListen([]string{":80", ":443"}); err != nil {
+		t.Fatalf("SetListen: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := c.SetAutomaticHTTPS(caddy.AutomaticHTTPS{DisableRedirects: true}); err != nil {
+			t.Fatalf("SetAutomaticHTTPS: %v", err)
+		}
+	}
+
+	var config caddy.Config
+	if err := json.Unmarshal(fake.Config(), &config); err != nil {
+		t.Fatal(err)
+	}
+	server := config.Server("srv0")
+	if !slices.Equal(server.Listen, []string{":80", ":443"}) {
+		t.Errorf("listen = %v", server.Listen)
+	}
+	if a := server.AutomaticHTTPS; a == nil || !a.DisableRedirects || a.Disable {
+		t.Errorf("automatic_https = %+v, want redirects disabled", a)
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	c, _ := newClient(t, `{}`)
+	err := c.RemoveRouteAt(0)
+	if err == nil || caddy.IsNotFound(err) {
+		t.Fatalf("RemoveRouteAt on an empty config = %v, want an API error", err)
+	}
+	var apiErr *caddy.Error
+	if !errors.As(err, &apiErr) || apiErr.Method != "DELETE" || apiErr.Message == "" {
+		t.Errorf("error = %#v", err)
+	}
+}
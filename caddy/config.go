@@ -0,0 +1,133 @@
+// Package caddy is a small typed client for the Caddy admin API, covering
+// the parts of the HTTP app Hoster configures.
+package caddy
+
+// Route is an entry in a server's or a subroute's route list
+type Route struct {
+	// ID is Caddy's @id, which makes the route addressable through /id/
+	ID       string    `json:"@id,omitempty"`
+	Match    []Match   `json:"match,omitempty"`
+	Handle   []Handler `json:"handle,omitempty"`
+	Terminal bool      `json:"terminal,omitempty"`
+}
+
+// MatchesHost reports whether one of the route's matchers includes host
+func (r Route) MatchesHost(host string) bool {
+	for _, m := range r.Match {
+		for _, h := range m.Host {
+			if h == host {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Match is a matcher set, all of its matchers have to match
+type Match struct {
+	Host []string   `json:"host,omitempty"`
+	Path []string   `json:"path,omitempty"`
+	File *FileMatch `json:"file,omitempty"`
+	Not  []Match    `json:"not,omitempty"`
+}
+
+// FileMatch matches requests for files that exist
+type FileMatch struct {
+	Root     string   `json:"root,omitempty"`
+	TryFiles []string `json:"try_files,omitempty"`
+}
+
+// Handler is an HTTP handler. Handler names the module, the other fields
+// are the options of the modules Hoster uses.
+type Handler struct {
+	Handler string `json:"handler"`
+
+	// file_server
+	Root string `json:"root,omitempty"`
+	// rewrite
+	URI string `json:"uri,omitempty"`
+	// subroute
+	Routes []Route `json:"routes,omitempty"`
+	// reverse_proxy
+	Upstreams []Upstream `json:"upstreams,omitempty"`
+	// static_response
+	StatusCode int    `json:"status_code,omitempty"`
+	Body       string `json:"body,omitempty"`
+}
+
+// Upstream is a reverse_proxy backend
+type Upstream struct {
+	Dial string `json:"dial"`
+}
+
+// FileServer serves files from root
+func FileServer(root string) Handler {
+	return Handler{Handler: "file_server", Root: root}
+}
+
+// Rewrite rewrites the request URI
+func Rewrite(uri string) Handler {
+	return Handler{Handler: "rewrite", URI: uri}
+}
+
+// Subroute runs routes in order
+func Subroute(routes ...Route) Handler {
+	return Handler{Handler: "subroute", Routes: routes}
+}
+
+// ReverseProxy proxies to the given dial addresses, e.g. localhost:9000
+func ReverseProxy(dials ...string) Handler {
+	h := Handler{Handler: "reverse_proxy"}
+	for _, dial := range dials {
+		h.Upstreams = append(h.Upstreams, Upstream{Dial: dial})
+	}
+	return h
+}
+
+// StaticResponse answers with a fixed status and body
+func StaticResponse(status int, body string) Handler {
+	return Handler{Handler: "static_response", StatusCode: status, Body: body}
+}
+
+// Config is the part of Caddy's configuration Hoster reads
+type Config struct {
+	Apps struct {
+		HTTP *HTTPApp `json:"http,omitempty"`
+		TLS  *TLSApp  `json:"tls,omitempty"`
+	} `json:"apps"`
+}
+
+// HTTPApp is the http app's configuration
+type HTTPApp struct {
+	Servers map[string]*Server `json:"servers"`
+}
+
+// Server is an HTTP server of the http app
+type Server struct {
+	Listen         []string        `json:"listen,omitempty"`
+	Routes         []Route         `json:"routes,omitempty"`
+	AutomaticHTTPS *AutomaticHTTPS `json:"automatic_https,omitempty"`
+}
+
+// AutomaticHTTPS controls certificate management and redirects for the
+// hosts a server's routes match
+type AutomaticHTTPS struct {
+	Disable          bool `json:"disable,omitempty"`
+	DisableRedirects bool `json:"disable_redirects,omitempty"`
+}
+
+// Policies returns the tls app's automation policies
+func (c *Config) Policies() []AutomationPolicy {
+	if c == nil || c.Apps.TLS == nil || c.Apps.TLS.Automation == nil {
+		return nil
+	}
+	return c.Apps.TLS.Automation.Policies
+}
+
+// Server returns the named server, nil if the config has none
+func (c *Config) Server(name string) *Server {
+	if c == nil || c.Apps.HTTP == nil {
+		return nil
+	}
+	return c.Apps.HTTP.Servers[name]
+}
@@ -0,0 +1,104 @@
+package caddy
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// TLSApp is the tls app's configuration
+type TLSApp struct {
+	Automation *Automation `json:"automation,omitempty"`
+}
+
+// Automation holds the policies Caddy manages certificates with
+type Automation struct {
+	Policies []AutomationPolicy `json:"policies,omitempty"`
+}
+
+// AutomationPolicy says how certificates for its subjects are obtained
+type AutomationPolicy struct {
+	ID       string   `json:"@id,omitempty"`
+	Subjects []string `json:"subjects,omitempty"`
+	Issuers  []Issuer `json:"issuers,omitempty"`
+}
+
+// Issuer is a certificate issuer module, acme or internal
+type Issuer struct {
+	Module string `json:"module"`
+
+	// acme
+	CA                   string   `json:"ca,omitempty"`
+	Email                string   `json:"email,omitempty"`
+	TrustedRootsPEMFiles []string `json:"trusted_roots_pem_files,omitempty"`
+}
+
+// ACMEIssuer gets certificates from the ACME directory at ca, e.g. Let's
+// Encrypt or a local Pebble. rootPEM is the CA's root when it isn't
+// publicly trusted.
+func ACMEIssuer(ca, email, rootPEM string) Issuer {
+	issuer := Issuer{Module: "acme", CA: ca, Email: email}
+	if rootPEM != "" {
+		issuer.TrustedRootsPEMFiles = []string{rootPEM}
+	}
+	return issuer
+}
+
+// InternalIssuer signs certificates with Caddy's local CA
+func InternalIssuer() Issuer {
+	return Issuer{Module: "internal"}
+}
+
+const (
+	tlsPath        = "/config/apps/tls"
+	automationPath = tlsPath + "/automation"
+	policiesPath   = automationPath + "/policies"
+)
+
+// UpsertPolicy replaces the automation policy with policy.ID in place, or
+// inserts it ahead of the other policies, creating the tls app if needed
+func (c *Client) UpsertPolicy(policy AutomationPolicy) error {
+	if policy.ID == "" {
+		return fmt.Errorf("policy has no @id")
+	}
+	existing, err := c.exists(idPath(policy.ID))
+	if err != nil {
+		return err
+	}
+	if existing {
+		return c.do(http.MethodPatch, idPath(policy.ID), policy, nil)
+	}
+
+	// Missing or null parents have to be created with the policy inside
+	var raw json.RawMessage
+	if err := c.do(http.MethodGet, tlsPath, nil, &raw); err != nil {
+		return err
+	}
+	if isNull(raw) {
+		return c.do(http.MethodPost, tlsPath, TLSApp{Automation: &Automation{Policies: []AutomationPolicy{policy}}}, nil)
+	}
+	if err := c.do(http.MethodGet, automationPath, nil, &raw); err != nil {
+		return err
+	}
+	if isNull(raw) {
+		return c.do(http.MethodPost, automationPath, Automation{Policies: []AutomationPolicy{policy}}, nil)
+	}
+	return c.insertFront(policiesPath, policy)
+}
+
+// RemovePolicy deletes the automation policy with the given @id. Removing
+// a policy that does not exist is not an error.
+func (c *Client) RemovePolicy(id string) error {
+	return c.removeID(id)
+}
+
+// SetListen replaces the addresses the server listens on
+func (c *Client) SetListen(addresses []string) error {
+	return c.do(http.MethodPatch, c.serverPath()+"/listen", addresses, nil)
+}
+
+// SetAutomaticHTTPS sets the server's automatic HTTPS options
+func (c *Client) SetAutomaticHTTPS(options AutomaticHTTPS) error {
+	// POST creates or replaces an object
+	return c.do(http.MethodPost, c.serverPath()+"/automatic_https", options, nil)
+}
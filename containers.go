@@ -0,0 +1,307 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ImageSpec describes an image build from a Dockerfile
+type ImageSpec struct {
+	// Dir is the build context
+	Dir        string
+	Dockerfile string
+	Tag        string
+}
+
+// ContainerSpec describes how an app container is run
+type ContainerSpec struct {
+	Name  string
+	Image string
+	// Port on 127.0.0.1 is published to ContainerPort
+	Port          int
+	ContainerPort int
+	// EnvKeys are passed by name only, the values come from the
+	// environment of the command so secrets stay out of its arguments
+	EnvKeys []string
+	// Env are passed with their values, for the variables the CLI would
+	// read itself if they were in its environment
+	Env []string
+	// Command replaces the image's CMD when set
+	Command []string
+	// Mounts are host directories mounted at the same path. Workdir is
+	// the directory the command runs in and User who it runs as.
+	Mounts  []string
+	Workdir string
+	User    string
+}
+
+// ContainerRuntime builds and runs app images
+type ContainerRuntime interface {
+	Build(ctx context.Context, spec ImageSpec, out io.Writer) error
+	// RunCommand returns a command running the container in the foreground,
+	// so the supervisor can treat it like any other app process
+	RunCommand(spec ContainerSpec) []string
+	Remove(name string) error
+	RemoveImage(image string) error
+}
+
+// newContainerRuntimeFromEnv returns the runtime configured through
+// HOSTER_CONTAINER_RUNTIME ("docker" or "podman"). HOSTER_IMAGE_BUILDER=buildx
+// builds with docker buildx instead of the daemon's builder.
+func newContainerRuntimeFromEnv() ContainerRuntime {
+	return &cliRuntime{
+		binary: envOr("HOSTER_CONTAINER_RUNTIME", "docker"),
+		buildx: os.Getenv("HOSTER_IMAGE_BUILDER") == "buildx",
+	}
+}
+
+// cliRuntime drives a docker compatible CLI
+type cliRuntime struct {
+	binary string
+	buildx bool
+}
+
+func (r *cliRuntime) Build(ctx context.Context, spec ImageSpec, out io.Writer) error {
+	args := []string{"build"}
+	if r.buildx {
+		args = []string{"buildx", "build", "--load"}
+	}
+	args = append(args, "--tag", spec.Tag, "--file", spec.Dockerfile, spec.Dir)
+
+	cmd := exec.CommandContext(ctx, r.binary, args...)
+	cmd.Env = append(runtimeEnv(), "DOCKER_BUILDKIT=1")
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("image build timed out")
+		}
+		return fmt.Errorf("image build failed: %v", err)
+	}
+	return nil
+}
+
+func (r *cliRuntime) RunCommand(spec ContainerSpec) []string {
+	args := []string{
+		r.binary, "run", "--rm", "--name", spec.Name,
+		"--publish", fmt.Sprintf("127.0.0.1:%d:%d", spec.Port, spec.ContainerPort),
+		"--env", fmt.Sprintf("PORT=%d", spec.ContainerPort),
+	}
+	for _, kv := range spec.Env {
+		args = append(args, "--env", kv)
+	}
+	for _, key := range spec.EnvKeys {
+		args = append(args, "--env", key)
+	}
+	if spec.User != "" {
+		args = append(args, "--user", spec.User)
+	}
+	for _, mount := range spec.Mounts {
+		args = append(args, "--volume", mount+":"+mount)
+	}
+	if spec.Workdir != "" {
+		args = append(args, "--workdir", spec.Workdir)
+	}
+	args = append(args, spec.Image)
+	return append(args, spec.Command...)
+}
+
+func (r *cliRuntime) Remove(name string) error {
+	return exec.Command(r.binary, "rm", "--force", name).Run()
+}
+
+func (r *cliRuntime) RemoveImage(image string) error {
+	return exec.Command(r.binary, "rmi", image).Run()
+}
+
+// runtimeEnvKeys are the variables of the server's environment the
+// container CLI needs to reach its daemon
+var runtimeEnvKeys = []string{"DOCKER_HOST", "DOCKER_CONTEXT", "DOCKER_CONFIG", "DOCKER_CERT_PATH", "DOCKER_TLS_VERIFY", "CONTAINER_HOST", "CONTAINER_CONNECTION", "XDG_RUNTIME_DIR"}
+
+// runtimeEnv is the environment of container CLI commands, without the
+// server's secrets
+func runtimeEnv() []string {
+	env := baseEnv()
+	for _, key := range runtimeEnvKeys {
+		if value, ok := os.LookupEnv(key); ok {
+			env = append(env, key+"="+value)
+		}
+	}
+	return env
+}
+
+// isRuntimeEnvKey reports whether the container CLI or the dynamic loader
+// reads key. Such variables of a project must not end up in the CLI's
+// environment, where they could redirect it or run code on the host.
+func isRuntimeEnvKey(key string) bool {
+	switch {
+	case slices.Contains(runtimeEnvKeys, key), slices.Contains(baseEnvKeys, key):
+		return true
+	case strings.HasPrefix(key, "LD_"), strings.HasPrefix(key, "XDG_"),
+		strings.HasPrefix(key, "CONTAINERS_"), strings.HasPrefix(key, "BUILDKIT_"),
+		strings.HasPrefix(key, "DOCKER_CLI_"), strings.HasPrefix(key, "DOCKER_API_"),
+		strings.HasPrefix(key, "DOCKER_CONTENT_TRUST"), strings.HasPrefix(key, "DOCKER_TLS"),
+		strings.HasSuffix(strings.ToUpper(key), "_PROXY"):
+		return true
+	}
+	return key == "DOCKER_BUILDKIT" || key == "DOCKER_DEFAULT_PLATFORM"
+}
+
+// splitRuntimeEnv splits vars into the ones passed with their value on
+// the command line and the names of the ones passed through the
+// environment, which are returned as env
+func splitRuntimeEnv(vars []string) (inline, keys, env []string) {
+	env = runtimeEnv()
+	for _, kv := range vars {
+		key, _, _ := strings.Cut(kv, "=")
+		if isRuntimeEnvKey(key) {
+			inline = append(inline, kv)
+			continue
+		}
+		keys = append(keys, key)
+		env = append(env, kv)
+	}
+	return inline, keys, env
+}
+
+// isDockerProject reports whether dir is built from a Dockerfile
+func isDockerProject(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, "Dockerfile"))
+	return err == nil
+}
+
+// exposedPort returns the first port a Dockerfile EXPOSEs, 0 if none
+func exposedPort(dockerfile string) int {
+	f, err := os.Open(dockerfile)
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || !strings.EqualFold(fields[0], "EXPOSE") {
+			continue
+		}
+		// e.g. "EXPOSE 8080/tcp"
+		port, _, _ := strings.Cut(fields[1], "/")
+		if n, err := strconv.Atoi(port); err == nil {
+			return n
+		}
+	}
+	return 0
+}
+
+// containerName is the container an app instance runs as
+func containerName(instance string) string {
+	return "hoster-" + strings.ReplaceAll(instance, "@", "-")
+}
+
+// imageTag is the image built for a deployment of site. Tags have to be
+// lowercase.
+func imageTag(site, deploymentID string) string {
+	return strings.ToLower("hoster/" + site + ":" + deploymentID)
+}
+
+// containerArgs turns the spec of a container app into the command the
+// supervisor runs and its environment. vars is the app's environment
+// without the base.
+func containerArgs(spec appSpec, vars []string) ([]string, []string) {
+	var appVars []string
+	for _, kv := range vars {
+		if !strings.HasPrefix(kv, "PORT=") {
+			appVars = append(appVars, kv)
+		}
+	}
+	inline, keys, env := splitRuntimeEnv(appVars)
+	containerPort := spec.ContainerPort
+	if containerPort == 0 {
+		containerPort = spec.Port
+	}
+	run := ContainerSpec{
+		Name:          containerName(spec.Name),
+		Image:         spec.Image,
+		Port:          spec.Port,
+		ContainerPort: containerPort,
+		EnvKeys:       keys,
+		Env:           inline,
+		Command:       spec.Command,
+		Mounts:        spec.Mounts,
+	}
+	// Files in the workspace belong to the user builds run as
+	if len(spec.Mounts) > 0 {
+		run.Workdir = spec.Dir
+		run.User = fmt.Sprintf("%d:%d", os.Getuid(), os.Getgid())
+	}
+	return containers.RunCommand(run), env
+}
+
+// removeContainerApp cleans up after a container app that is gone for good
+func removeContainerApp(spec appSpec) {
+	if spec.Image == "" {
+		return
+	}
+	containers.Remove(containerName(spec.Name))
+	// Toolchain images are shared, only images built for a deployment go
+	if !strings.HasPrefix(spec.Image, "hoster/") {
+		return
+	}
+	if err := containers.RemoveImage(spec.Image); err != nil {
+		fmt.Printf("Warning: Failed to remove image %s: %v\n", spec.Image, err)
+	}
+}
+
+// Deployment function for repositories with a Dockerfile. The image is
+// built by the container runtime and run as a supervised app.
+func deployDockerApp(job *deployJob, repoDir string) (string, error) {
+	dockerfile := filepath.Join(repoDir, "Dockerfile")
+	tag := imageTag(job.siteName(), job.ID)
+
+	job.setStatus(statusBuilding)
+	job.log.Printf("Building image %s...\n", tag)
+	ctx := context.Background()
+	if timeout := buildLimitsFromEnv().Timeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	if err := containers.Build(ctx, ImageSpec{Dir: repoDir, Dockerfile: dockerfile, Tag: tag}, job.log); err != nil {
+		return "", err
+	}
+
+	var command []string
+	if job.manifest.Start != "" {
+		command = []string{"sh", "-c", job.manifest.Start}
+	}
+
+	job.setStatus(statusPublishing)
+	dir, err := filepath.Abs(repoDir)
+	if err != nil {
+		return "", err
+	}
+	check := projectHealthCheck(job.Project)
+	if check == nil {
+		check = job.manifest.HealthCheck
+	}
+	return switchSlots(job, appSpec{
+		Name:          job.siteName(),
+		Project:       job.Project,
+		Environment:   job.Environment,
+		Untrusted:     job.Fork,
+		Dir:           dir,
+		Image:         tag,
+		ContainerPort: exposedPort(dockerfile),
+		Command:       command,
+		HealthCheck:   check,
+		Vars:          append(job.manifest.envList(), job.appVars...),
+	})
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/RajBhut/go-basics/model"
+)
+
+// fakeRuntime records what deployments ask of the container runtime and
+// runs this test binary in place of a container
+type fakeRuntime struct {
+	mu       sync.Mutex
+	buildErr error
+	builds   []ImageSpec
+	runs     []ContainerSpec
+	removed  []string
+	images   []string
+}
+
+func (f *fakeRuntime) Build(ctx context.Context, spec ImageSpec, out io.Writer) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.builds = append(f.builds, spec)
+	fmt.Fprintf(out, "built %s\n", spec.Tag)
+	return f.buildErr
+}
+
+func (f *fakeRuntime) RunCommand(spec ContainerSpec) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.runs = append(f.runs, spec)
+	self, _ := os.Executable()
+	return []string{self, "-test.run=^TestContainerHelperProcess$", "--", strconv.Itoa(spec.Port)}
+}
+
+func (f *fakeRuntime) Remove(name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.removed = append(f.removed, name)
+	return nil
+}
+
+func (f *fakeRuntime) RemoveImage(image string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.images = append(f.images, image)
+	return nil
+}
+
+// TestContainerHelperProcess is the "container" fakeRuntime runs, an HTTP
+// server on the port after "--"
+func TestContainerHelperProcess(t *testing.T) {
+	if os.Getenv("HOSTER_TEST_CONTAINER") != "1" {
+		return
+	}
+	port := os.Args[len(os.Args)-1]
+	http.ListenAndServe(net.JoinHostPort("127.0.0.1", port), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "ok")
+	}))
+	os.Exit(0)
+}
+
+func setupFakeRuntime(t *testing.T) *fakeRuntime {
+	t.Helper()
+	old := containers
+	t.Cleanup(func() { containers = old })
+	fake := &fakeRuntime{}
+	containers = fake
+	return fake
+}
+
+func writeRepo(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestDetectProjectTypeDockerfile(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  string
+	}{
+		{"dockerfile only", map[string]string{"Dockerfile": "FROM scratch\n"}, "docker"},
+		{"node with dockerfile", map[string]string{"Dockerfile": "FROM node\n", "package.json": "{}"}, "node"},
+		{"go with dockerfile", map[string]string{"Dockerfile": "FROM golang\n", "go.mod": "module x\n"}, "go"},
+		{"python with dockerfile", map[string]string{"Dockerfile": "FROM python\n", "requirements.txt": ""}, "python"},
+		{"nothing", map[string]string{"README.md": "hi\n"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detectProjectType(writeRepo(t, tt.files)); got != tt.want {
+				t.Errorf("detectProjectType = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExposedPort(t *testing.T) {
+	tests := []struct {
+		dockerfile string
+		want       int
+	}{
+		{"FROM nginx\nEXPOSE 8080\n", 8080},
+		{"FROM nginx\nexpose 3000/tcp 3001\n", 3000},
+		{"FROM nginx\n# EXPOSE 80\n", 0},
+		{"FROM nginx\nEXPOSE $PORT\nEXPOSE 5000\n", 5000},
+	}
+	for _, tt := range tests {
+		dir := writeRepo(t, map[string]string{"Dockerfile": tt.dockerfile})
+		if got := exposedPort(filepath.Join(dir, "Dockerfile")); got != tt.want {
+			t.Errorf("exposedPort(%q) = %d, want %d", tt.dockerfile, got, tt.want)
+		}
+	}
+}
+
+func TestContainerArgsPassesEnvByName(t *testing.T) {
+	fake := setupFakeRuntime(t)
+	_, env := containerArgs(appSpec{Name: "site@blue", Image: "hoster/site:1", Port: 9001},
+		[]string{"API_TOKEN=hunter2", "NODE_ENV=production", "LD_PRELOAD=/srv/evil.so", "PORT=9001"})
+
+	if len(fake.runs) != 1 {
+		t.Fatalf("RunCommand called %d times, want 1", len(fake.runs))
+	}
+	spec := fake.runs[0]
+	if spec.Name != "hoster-site-blue" || spec.Image != "hoster/site:1" {
+		t.Errorf("ran %s from %s, want hoster-site-blue from hoster/site:1", spec.Name, spec.Image)
+	}
+	if spec.ContainerPort != 9001 {
+		t.Errorf("ContainerPort = %d, want the host port without EXPOSE", spec.ContainerPort)
+	}
+	if !slices.Equal(spec.EnvKeys, []string{"API_TOKEN", "NODE_ENV"}) {
+		t.Errorf("EnvKeys = %v, want the names without PORT", spec.EnvKeys)
+	}
+	if !slices.Equal(spec.Env, []string{"LD_PRELOAD=/srv/evil.so"}) {
+		t.Errorf("Env = %v, want the variables the CLI would read", spec.Env)
+	}
+	if !slices.Contains(env, "API_TOKEN=hunter2") || slices.Contains(env, "LD_PRELOAD=/srv/evil.so") {
+		t.Errorf("CLI environment = %v, want secrets but not LD_PRELOAD", env)
+	}
+}
+
+func TestDeployDockerApp(t *testing.T) {
+	fake := setupFakeRuntime(t)
+	setupCaddyTest(t)
+	old := deploymentLogDir
+	t.Cleanup(func() { deploymentLogDir = old })
+	deploymentLogDir = t.TempDir()
+
+	repo := writeRepo(t, map[string]string{"Dockerfile": "FROM scratch\nEXPOSE 8080\n"})
+	job := newDeployJob("octo-org", "site", "main", "")
+	job.manifest = &Manifest{
+		Env:         map[string]string{"HOSTER_TEST_CONTAINER": "1"},
+		HealthCheck: &model.HealthCheck{Type: "http", Timeout: 10},
+	}
+
+	url, err := deployDockerApp(job, repo)
+	if err != nil {
+		t.Fatalf("deployDockerApp: %v", err)
+	}
+	instance := liveInstance("site")
+	defer apps.remove(instance)
+
+	if url != "http://"+projectHost("site") {
+		t.Errorf("url = %s", url)
+	}
+	tag := imageTag("site", job.ID)
+	if len(fake.builds) != 1 || fake.builds[0].Tag != tag || fake.builds[0].Dir != repo {
+		t.Fatalf("builds = %+v, want one of %s from %s", fake.builds, tag, repo)
+	}
+	if len(fake.runs) != 1 || fake.runs[0].Image != tag || fake.runs[0].ContainerPort != 8080 {
+		t.Fatalf("runs = %+v, want %s on the exposed port", fake.runs, tag)
+	}
+	if !slices.Contains(fake.runs[0].EnvKeys, "HOSTER_TEST_CONTAINER") {
+		t.Errorf("EnvKeys = %v, want the manifest's variables", fake.runs[0].EnvKeys)
+	}
+
+	routes, err := caddyAdmin.Routes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(routes) != 1 || routes[0].ID != routeID(projectHost("site")) {
+		t.Errorf("routes = %+v, want one for the site", routes)
+	}
+
+	apps.remove(instance)
+	if !slices.Contains(fake.removed, containerName(instance)) || !slices.Contains(fake.images, tag) {
+		t.Errorf("removed containers %v and images %v, want %s and %s", fake.removed, fake.images, containerName(instance), tag)
+	}
+}
+
+func TestDeployDockerAppBuildFailure(t *testing.T) {
+	fake := setupFakeRuntime(t)
+	fake.buildErr = fmt.Errorf("image build failed: exit status 1")
+	setupCaddyTest(t)
+	old := deploymentLogDir
+	t.Cleanup(func() { deploymentLogDir = old })
+	deploymentLogDir = t.TempDir()
+
+	repo := writeRepo(t, map[string]string{"Dockerfile": "FROM scratch\n"})
+	job := newDeployJob("octo-org", "site", "main", "")
+	job.manifest = &Manifest{}
+
+	if _, err := deployDockerApp(job, repo); err == nil {
+		t.Fatal("deployDockerApp succeeded with a failing build")
+	}
+	if len(fake.runs) != 0 || len(apps.names()) != 0 {
+		t.Errorf("started %d containers after a failed build", len(fake.runs))
+	}
+}
+
+func TestToolchainAppWorkspaceRemovedWhenDrained(t *testing.T) {
+	fake := setupFakeRuntime(t)
+	setupCaddyTest(t)
+	oldLogDir, oldRoot, oldGrace := deploymentLogDir, deploymentRootDir, drainGracePeriod
+	t.Cleanup(func() { deploymentLogDir, deploymentRootDir, drainGracePeriod = oldLogDir, oldRoot, oldGrace })
+	deploymentLogDir = t.TempDir()
+	deploymentRootDir = t.TempDir()
+	drainGracePeriod = 0
+
+	deploy := func(id string) string {
+		t.Helper()
+		workspace := filepath.Join(deploymentRootDir, id)
+		repoDir := filepath.Join(workspace, "app")
+		if err := os.MkdirAll(repoDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		job := newDeployJob("octo-org", "api", "main", "")
+		job.manifest = &Manifest{
+			Env:         map[string]string{"HOSTER_TEST_CONTAINER": "1"},
+			HealthCheck: &model.HealthCheck{Type: "http", Timeout: 10},
+		}
+		if _, err := startToolchainApp(job, repoDir, "python", "sh", "-c", "exec python app.py"); err != nil {
+			t.Fatalf("startToolchainApp: %v", err)
+		}
+		return workspace
+	}
+
+	first := deploy("api-1")
+	spec := fake.runs[0]
+	if spec.Image != toolchainImage("python", "") || !slices.Equal(spec.Mounts, []string{filepath.Join(first, "app")}) || spec.Workdir != filepath.Join(first, "app") || spec.User == "" {
+		t.Errorf("ran %+v, want the python image with the workspace mounted", spec)
+	}
+
+	second := deploy("api-2")
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := os.Stat(first); os.IsNotExist(err) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("workspace of the drained instance was kept")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	apps.remove(liveInstance("api"))
+	if _, err := os.Stat(second); !os.IsNotExist(err) {
+		t.Errorf("workspace of the removed instance was kept: %v", err)
+	}
+	if len(fake.images) != 0 {
+		t.Errorf("removed images %v, want the shared toolchain image kept", fake.images)
+	}
+}
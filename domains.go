@@ -0,0 +1,422 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RajBhut/go-basics/caddy"
+	"github.com/RajBhut/go-basics/ent"
+	"github.com/RajBhut/go-basics/ent/domain"
+	"github.com/RajBhut/go-basics/ent/project"
+	"github.com/gin-gonic/gin"
+)
+
+// Ownership of a custom domain is proven either with a TXT record or by
+// serving a token over HTTP from the domain
+const (
+	dnsChallengePrefix = "_hoster-challenge."
+	dnsChallengeValue  = "hoster-verification="
+	httpChallengePath  = "/.well-known/hoster-challenge/"
+)
+
+// TXTResolver looks up DNS TXT records, *net.Resolver implements it
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+var (
+	txtResolver TXTResolver = net.DefaultResolver
+
+	// challengeClient fetches HTTP tokens. Redirects are not followed so a
+	// domain can't borrow another site's token.
+	challengeClient = &http.Client{
+		Timeout: 10 * time.Second,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	hostnamePattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+)
+
+// normalizeHostname lowercases a custom domain and rejects anything that
+// isn't a public hostname
+func normalizeHostname(s string) (string, error) {
+	host := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(s)), ".")
+	if !hostnamePattern.MatchString(host) || len(host) > 253 {
+		return "", fmt.Errorf("invalid hostname %q", s)
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return "", fmt.Errorf("%s is served by Hoster already", host)
+	}
+	return host, nil
+}
+
+func newVerificationToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// verifiedDomains returns the verified custom domains of a site. Previews
+// only have their generated host.
+func verifiedDomains(site string) []string {
+	if db == nil || site == "" || siteProject(site) != site {
+		return nil
+	}
+	hosts, err := db.Domain.Query().
+		Where(domain.Verified(true), domain.HasProjectWith(project.Name(site))).
+		Order(ent.Asc(domain.FieldHostname)).
+		Select(domain.FieldHostname).
+		Strings(dbCtx)
+	if err != nil {
+		fmt.Printf("Warning: Failed to load domains of %s: %v\n", site, err)
+		return nil
+	}
+	return hosts
+}
+
+// routeHosts returns every hostname the route for a site's host matches:
+// the generated one first, then its verified custom domains
+func routeHosts(host string) []string {
+	site, _ := strings.CutSuffix(host, projectHost(""))
+	return append([]string{host}, verifiedDomains(site)...)
+}
+
+// challengeRouteID includes the domain's ID as several projects may be
+// verifying the same hostname
+func challengeRouteID(d *ent.Domain) string {
+	return fmt.Sprintf("hoster-challenge-%s-%d", d.Hostname, d.ID)
+}
+
+// challengeRoute answers the HTTP challenge of a domain awaiting
+// verification. The host is matched in a subroute because Caddy requests
+// certificates for hosts in top level matchers, and the domain shouldn't
+// get one before it is verified.
+func challengeRoute(d *ent.Domain) caddy.Route {
+	return caddy.Route{
+		ID:    challengeRouteID(d),
+		Match: []caddy.Match{{Path: []string{httpChallengePath + d.Token}}},
+		Handle: []caddy.Handler{caddy.Subroute(caddy.Route{
+			Match:  []caddy.Match{{Host: []string{d.Hostname}}},
+			Handle: []caddy.Handler{caddy.StaticResponse(http.StatusOK, d.Token)},
+		})},
+		Terminal: true,
+	}
+}
+
+func setChallengeRoute(d *ent.Domain) error {
+	caddyMu.Lock()
+	defer caddyMu.Unlock()
+	if err := caddyAdmin.UpsertRoute(challengeRoute(d)); err != nil {
+		return fmt.Errorf("failed to add challenge route: %v", err)
+	}
+	saveCaddySnapshot()
+	return nil
+}
+
+func removeChallengeRoute(d *ent.Domain) error {
+	caddyMu.Lock()
+	defer caddyMu.Unlock()
+	if err := caddyAdmin.RemoveRoute(challengeRouteID(d)); err != nil {
+		return fmt.Errorf("failed to remove challenge route: %v", err)
+	}
+	saveCaddySnapshot()
+	return nil
+}
+
+// verifyDomain checks the domain's TXT record or HTTP token
+func verifyDomain(d *ent.Domain) error {
+	if d.Method == domain.MethodHTTP {
+		url := "http://" + d.Hostname + httpChallengePath + d.Token
+		resp, err := challengeClient.Get(url)
+		if err != nil {
+			return fmt.Errorf("could not fetch %s: %v", url, err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		if resp.StatusCode != http.StatusOK || strings.TrimSpace(string(body)) != d.Token {
+			return fmt.Errorf("%s did not return the verification token", url)
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	name := dnsChallengePrefix + d.Hostname
+	records, err := txtResolver.LookupTXT(ctx, name)
+	if err != nil {
+		return fmt.Errorf("could not look up TXT records of %s: %v", name, err)
+	}
+	for _, record := range records {
+		if strings.TrimSpace(record) == dnsChallengeValue+d.Token {
+			return nil
+		}
+	}
+	return fmt.Errorf("no TXT record %q found on %s", dnsChallengeValue+d.Token, name)
+}
+
+// dropPendingClaims removes the other projects' unverified claims on a
+// hostname once it has been verified
+func dropPendingClaims(d *ent.Domain) {
+	claims, err := db.Domain.Query().
+		Where(domain.Hostname(d.Hostname), domain.Verified(false), domain.IDNEQ(d.ID)).
+		All(dbCtx)
+	if err != nil {
+		fmt.Printf("Warning: Failed to load claims on %s: %v\n", d.Hostname, err)
+		return
+	}
+	for _, claim := range claims {
+		if err := db.Domain.DeleteOne(claim).Exec(dbCtx); err != nil {
+			fmt.Printf("Warning: Failed to drop claim on %s: %v\n", d.Hostname, err)
+			continue
+		}
+		if claim.Method == domain.MethodHTTP {
+			if err := removeChallengeRoute(claim); err != nil {
+				fmt.Printf("Warning: %v\n", err)
+			}
+		}
+	}
+}
+
+// refreshSiteRoute re-registers a routed site so its match picks up
+// changed domains
+func refreshSiteRoute(site string) error {
+	exists, err := caddyRouteExists(projectHost(site))
+	if err != nil || !exists {
+		return err
+	}
+	_, err = registerSite(site)
+	return err
+}
+
+func domainJSON(d *ent.Domain) gin.H {
+	result := gin.H{
+		"hostname":    d.Hostname,
+		"method":      d.Method,
+		"verified":    d.Verified,
+		"verified_at": d.VerifiedAt,
+		"created_at":  d.CreatedAt,
+	}
+	if d.Verified {
+		return result
+	}
+	if d.Method == domain.MethodHTTP {
+		result["verification"] = gin.H{
+			"url":  "http://" + d.Hostname + httpChallengePath + d.Token,
+			"body": d.Token,
+		}
+	} else {
+		result["verification"] = gin.H{
+			"type":  "TXT",
+			"name":  dnsChallengePrefix + d.Hostname,
+			"value": dnsChallengeValue + d.Token,
+		}
+	}
+	return result
+}
+
+// findDomain loads a domain of the project from the :domain parameter
+func findDomain(c *gin.Context, p *ent.Project) (*ent.Domain, bool) {
+	hostname := strings.ToLower(c.Param("domain"))
+	d, err := db.Domain.Query().
+		Where(domain.Hostname(hostname), domain.HasProjectWith(project.ID(p.ID))).
+		Only(dbCtx)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "domain not found"})
+		return nil, false
+	}
+	return d, true
+}
+
+func listDomainsHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+	domains, err := p.QueryDomains().Order(ent.Asc(domain.FieldHostname)).All(dbCtx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not load domains"})
+		return
+	}
+	result := make([]gin.H, len(domains))
+	var wg sync.WaitGroup
+	for i, d := range domains {
+		result[i] = domainJSON(d)
+		if !d.Verified {
+			continue
+		}
+		// Certificates are checked in parallel, each takes a TLS handshake
+		wg.Add(1)
+		go func(entry gin.H, hostname string) {
+			defer wg.Done()
+			entry["certificate"] = certificateStatus(hostname)
+		}(result[i], d.Hostname)
+	}
+	wg.Wait()
+	c.JSON(http.StatusOK, result)
+}
+
+type addDomainRequest struct {
+	Hostname string `json:"hostname"`
+	Method   string `json:"method"`
+}
+
+// Attaches a custom domain to a project. It is routed once verified.
+func addDomainHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+	var req addDomainRequest
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+	hostname, err := normalizeHostname(req.Hostname)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	method := domain.MethodDNS
+	if req.Method != "" {
+		method = domain.Method(req.Method)
+		if err := domain.MethodValidator(method); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "method must be dns or http"})
+			return
+		}
+	}
+	// Unverified claims don't block anyone, so a hostname can't be
+	// squatted before its owner adds it
+	taken, err := db.Domain.Query().Where(domain.Hostname(hostname), domain.Verified(true)).Exist(dbCtx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not load domains"})
+		return
+	}
+	if taken {
+		c.JSON(http.StatusConflict, gin.H{"error": "domain is verified by another project"})
+		return
+	}
+	token, err := newVerificationToken()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not create token"})
+		return
+	}
+
+	d, err := db.Domain.Create().
+		SetHostname(hostname).
+		SetMethod(method).
+		SetToken(token).
+		SetProject(p).
+		Save(dbCtx)
+	if ent.IsConstraintError(err) {
+		c.JSON(http.StatusConflict, gin.H{"error": "domain is already attached to this project"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not save domain"})
+		return
+	}
+
+	if method == domain.MethodHTTP {
+		if err := setChallengeRoute(d); err != nil {
+			db.Domain.DeleteOne(d).Exec(dbCtx)
+			c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	c.JSON(http.StatusCreated, domainJSON(d))
+}
+
+// Checks ownership of a domain and adds it to the project's route
+func verifyDomainHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+	d, ok := findDomain(c, p)
+	if !ok {
+		return
+	}
+	if d.Verified {
+		result := domainJSON(d)
+		result["certificate"] = certificateStatus(d.Hostname)
+		c.JSON(http.StatusOK, result)
+		return
+	}
+
+	if err := verifyDomain(d); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": fmt.Sprintf("verification failed: %v", err)})
+		return
+	}
+	d, err := d.Update().SetVerified(true).SetVerifiedAt(time.Now()).Save(dbCtx)
+	if ent.IsConstraintError(err) {
+		c.JSON(http.StatusConflict, gin.H{"error": "domain is verified by another project"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not save domain"})
+		return
+	}
+	if d.Method == domain.MethodHTTP {
+		if err := removeChallengeRoute(d); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+	}
+	dropPendingClaims(d)
+	if err := setTLSPolicy(d.Hostname); err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": fmt.Sprintf("domain verified but TLS setup failed: %v", err)})
+		return
+	}
+	if err := refreshSiteRoute(p.Name); err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": fmt.Sprintf("domain verified but routing failed: %v", err)})
+		return
+	}
+	fmt.Printf("Verified %s for %s\n", d.Hostname, p.Name)
+	result := domainJSON(d)
+	result["certificate"] = certificateStatus(d.Hostname)
+	c.JSON(http.StatusOK, result)
+}
+
+func deleteDomainHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+	d, ok := findDomain(c, p)
+	if !ok {
+		return
+	}
+	if err := db.Domain.DeleteOne(d).Exec(dbCtx); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not delete domain"})
+		return
+	}
+	if err := removeChallengeRoute(d); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+	if !d.Verified {
+		// The hostname's policy, if any, belongs to the project that
+		// verified it
+		c.JSON(http.StatusOK, gin.H{"message": "Domain deleted"})
+		return
+	}
+	if err := refreshSiteRoute(p.Name); err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": fmt.Sprintf("domain deleted but routing failed: %v", err)})
+		return
+	}
+	if err := removeTLSPolicy(d.Hostname); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Domain deleted"})
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/RajBhut/go-basics/ent"
+	"github.com/RajBhut/go-basics/ent/domain"
+)
+
+// fakeResolver answers TXT lookups from a map, failing for other names
+type fakeResolver map[string][]string
+
+func (f fakeResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	records, ok := f[name]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return records, nil
+}
+
+func setupResolver(t *testing.T, records fakeResolver) {
+	t.Helper()
+	old := txtResolver
+	t.Cleanup(func() { txtResolver = old })
+	txtResolver = records
+}
+
+// setupChallengeServer sends every challenge request to handler
+func setupChallengeServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	old := challengeClient
+	t.Cleanup(func() { challengeClient = old })
+	client := *old
+	client.Transport = &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return net.Dial(network, server.Listener.Addr().String())
+		},
+	}
+	challengeClient = &client
+}
+
+func TestVerifyDomainDNS(t *testing.T) {
+	d := &ent.Domain{Hostname: "www.example.com", Method: domain.MethodDNS, Token: "abc123"}
+	tests := []struct {
+		name    string
+		records fakeResolver
+		wantErr string
+	}{
+		{"matching record", fakeResolver{"_hoster-challenge.www.example.com": {"other", " hoster-verification=abc123 "}}, ""},
+		{"wrong token", fakeResolver{"_hoster-challenge.www.example.com": {"hoster-verification=nope"}}, "no TXT record"},
+		{"record on the hostname", fakeResolver{"www.example.com": {"hoster-verification=abc123"}}, "could not look up"},
+		{"no records", fakeResolver{"_hoster-challenge.www.example.com": nil}, "no TXT record"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupResolver(t, tt.records)
+			err := verifyDomain(d)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("verifyDomain = %v, want nil", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("verifyDomain = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyDomainHTTP(t *testing.T) {
+	d := &ent.Domain{Hostname: "www.example.com", Method: domain.MethodHTTP, Token: "abc123"}
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		wantErr bool
+	}{
+		{"token served", func(w http.ResponseWriter, r *http.Request) {
+			if r.Host != d.Hostname || r.URL.Path != httpChallengePath+d.Token {
+				http.NotFound(w, r)
+				return
+			}
+			fmt.Fprintln(w, d.Token)
+		}, false},
+		{"wrong body", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, "abc")
+		}, true},
+		{"not found", http.NotFound, true},
+		{"redirect", func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "http://other.example.com"+r.URL.Path, http.StatusFound)
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupChallengeServer(t, tt.handler)
+			if err := verifyDomain(d); (err != nil) != tt.wantErr {
+				t.Errorf("verifyDomain = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChallengeRoutesOfTwoClaims(t *testing.T) {
+	a := &ent.Domain{ID: 1, Hostname: "www.example.com", Method: domain.MethodHTTP, Token: "aaa"}
+	b := &ent.Domain{ID: 2, Hostname: "www.example.com", Method: domain.MethodHTTP, Token: "bbb"}
+	setupCaddyTest(t)
+
+	for _, d := range []*ent.Domain{a, b} {
+		if err := setChallengeRoute(d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := removeChallengeRoute(a); err != nil {
+		t.Fatal(err)
+	}
+	routes, err := caddyAdmin.Routes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(routes) != 1 || routes[0].ID != challengeRouteID(b) {
+		t.Errorf("routes = %+v, want only the other project's challenge", routes)
+	}
+}
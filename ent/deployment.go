@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/RajBhut/go-basics/ent/deployment"
+	"github.com/RajBhut/go-basics/ent/project"
+	"github.com/RajBhut/go-basics/ent/user"
+)
+
+// Deployment is the model entity for the Deployment schema.
+type Deployment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// Repo holds the value of the "repo" field.
+	Repo string `json:"repo,omitempty"`
+	// Ref holds the value of the "ref" field.
+	Ref string `json:"ref,omitempty"`
+	// Environment holds the value of the "environment" field.
+	Environment string `json:"environment,omitempty"`
+	// PrNumber holds the value of the "pr_number" field.
+	PrNumber int `json:"pr_number,omitempty"`
+	// CommitSha holds the value of the "commit_sha" field.
+	CommitSha string `json:"commit_sha,omitempty"`
+	// ProjectType holds the value of the "project_type" field.
+	ProjectType string `json:"project_type,omitempty"`
+	// BuildDir holds the value of the "build_dir" field.
+	BuildDir string `json:"build_dir,omitempty"`
+	// CacheStatus holds the value of the "cache_status" field.
+	CacheStatus string `json:"cache_status,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeploymentQuery when eager-loading is set.
+	Edges               DeploymentEdges `json:"edges"`
+	project_deployments *int
+	user_deployments    *int
+	selectValues        sql.SelectValues
+}
+
+// DeploymentEdges holds the relations/edges for other nodes in the graph.
+type DeploymentEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeploymentEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeploymentEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Deployment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deployment.FieldPrNumber:
+			values[i] = new(sql.NullInt64)
+		case deployment.FieldID, deployment.FieldOwner, deployment.FieldRepo, deployment.FieldRef, deployment.FieldEnvironment, deployment.FieldCommitSha, deployment.FieldProjectType, deployment.FieldBuildDir, deployment.FieldCacheStatus, deployment.FieldURL, deployment.FieldStatus, deployment.FieldError:
+			values[i] = new(sql.NullString)
+		case deployment.FieldCreatedAt, deployment.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case deployment.ForeignKeys[0]: // project_deployments
+			values[i] = new(sql.NullInt64)
+		case deployment.ForeignKeys[1]: // user_deployments
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Deployment fields.
+func (d *Deployment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deployment.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				d.ID = value.String
+			}
+		case deployment.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				d.Owner = value.String
+			}
+		case deployment.FieldRepo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field repo", values[i])
+			} else if value.Valid {
+				d.Repo = value.String
+			}
+		case deployment.FieldRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ref", values[i])
+			} else if value.Valid {
+				d.Ref = value.String
+			}
+		case deployment.FieldEnvironment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment", values[i])
+			} else if value.Valid {
+				d.Environment = value.String
+			}
+		case deployment.FieldPrNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pr_number", values[i])
+			} else if value.Valid {
+				d.PrNumber = int(value.Int64)
+			}
+		case deployment.FieldCommitSha:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commit_sha", values[i])
+			} else if value.Valid {
+				d.CommitSha = value.String
+			}
+		case deployment.FieldProjectType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project_type", values[i])
+			} else if value.Valid {
+				d.ProjectType = value.String
+			}
+		case deployment.FieldBuildDir:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field build_dir", values[i])
+			} else if value.Valid {
+				d.BuildDir = value.String
+			}
+		case deployment.FieldCacheStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_status", values[i])
+			} else if value.Valid {
+				d.CacheStatus = value.String
+			}
+		case deployment.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				d.URL = value.String
+			}
+		case deployment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				d.Status = value.String
+			}
+		case deployment.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				d.Error = value.String
+			}
+		case deployment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				d.CreatedAt = value.Time
+			}
+		case deployment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				d.UpdatedAt = value.Time
+			}
+		case deployment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field project_deployments", value)
+			} else if value.Valid {
+				d.project_deployments = new(int)
+				*d.project_deployments = int(value.Int64)
+			}
+		case deployment.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_deployments", value)
+			} else if value.Valid {
+				d.user_deployments = new(int)
+				*d.user_deployments = int(value.Int64)
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Deployment.
+// This includes values selected through modifiers, order, etc.
+func (d *Deployment) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the Deployment entity.
+func (d *Deployment) QueryProject() *ProjectQuery {
+	return NewDeploymentClient(d.config).QueryProject(d)
+}
+
+// QueryUser queries the "user" edge of the Deployment entity.
+func (d *Deployment) QueryUser() *UserQuery {
+	return NewDeploymentClient(d.config).QueryUser(d)
+}
+
+// Update returns a builder for updating this Deployment.
+// Note that you need to call Deployment.Unwrap() before calling this method if this Deployment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Deployment) Update() *DeploymentUpdateOne {
+	return NewDeploymentClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Deployment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Deployment) Unwrap() *Deployment {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Deployment is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Deployment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Deployment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("owner=")
+	builder.WriteString(d.Owner)
+	builder.WriteString(", ")
+	builder.WriteString("repo=")
+	builder.WriteString(d.Repo)
+	builder.WriteString(", ")
+	builder.WriteString("ref=")
+	builder.WriteString(d.Ref)
+	builder.WriteString(", ")
+	builder.WriteString("environment=")
+	builder.WriteString(d.Environment)
+	builder.WriteString(", ")
+	builder.WriteString("pr_number=")
+	builder.WriteString(fmt.Sprintf("%v", d.PrNumber))
+	builder.WriteString(", ")
+	builder.WriteString("commit_sha=")
+	builder.WriteString(d.CommitSha)
+	builder.WriteString(", ")
+	builder.WriteString("project_type=")
+	builder.WriteString(d.ProjectType)
+	builder.WriteString(", ")
+	builder.WriteString("build_dir=")
+	builder.WriteString(d.BuildDir)
+	builder.WriteString(", ")
+	builder.WriteString("cache_status=")
+	builder.WriteString(d.CacheStatus)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(d.URL)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(d.Status)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(d.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(d.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Deployments is a parsable slice of Deployment.
+type Deployments []*Deployment
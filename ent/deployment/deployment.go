@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deployment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the deployment type in the database.
+	Label = "deployment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldRepo holds the string denoting the repo field in the database.
+	FieldRepo = "repo"
+	// FieldRef holds the string denoting the ref field in the database.
+	FieldRef = "ref"
+	// FieldEnvironment holds the string denoting the environment field in the database.
+	FieldEnvironment = "environment"
+	// FieldPrNumber holds the string denoting the pr_number field in the database.
+	FieldPrNumber = "pr_number"
+	// FieldCommitSha holds the string denoting the commit_sha field in the database.
+	FieldCommitSha = "commit_sha"
+	// FieldProjectType holds the string denoting the project_type field in the database.
+	FieldProjectType = "project_type"
+	// FieldBuildDir holds the string denoting the build_dir field in the database.
+	FieldBuildDir = "build_dir"
+	// FieldCacheStatus holds the string denoting the cache_status field in the database.
+	FieldCacheStatus = "cache_status"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeProject holds the string denoting the project edge name in mutations.
+	EdgeProject = "project"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the deployment in the database.
+	Table = "deployments"
+	// ProjectTable is the table that holds the project relation/edge.
+	ProjectTable = "deployments"
+	// ProjectInverseTable is the table name for the Project entity.
+	// It exists in this package in order to avoid circular dependency with the "project" package.
+	ProjectInverseTable = "projects"
+	// ProjectColumn is the table column denoting the project relation/edge.
+	ProjectColumn = "project_deployments"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "deployments"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_deployments"
+)
+
+// Columns holds all SQL columns for deployment fields.
+var Columns = []string{
+	FieldID,
+	FieldOwner,
+	FieldRepo,
+	FieldRef,
+	FieldEnvironment,
+	FieldPrNumber,
+	FieldCommitSha,
+	FieldProjectType,
+	FieldBuildDir,
+	FieldCacheStatus,
+	FieldURL,
+	FieldStatus,
+	FieldError,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "deployments"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"project_deployments",
+	"user_deployments",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// OwnerValidator is a validator for the "owner" field. It is called by the builders before save.
+	OwnerValidator func(string) error
+	// RepoValidator is a validator for the "repo" field. It is called by the builders before save.
+	RepoValidator func(string) error
+	// DefaultEnvironment holds the default value on creation for the "environment" field.
+	DefaultEnvironment string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Deployment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
+// ByRepo orders the results by the repo field.
+func ByRepo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRepo, opts...).ToFunc()
+}
+
+// ByRef orders the results by the ref field.
+func ByRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRef, opts...).ToFunc()
+}
+
+// ByEnvironment orders the results by the environment field.
+func ByEnvironment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvironment, opts...).ToFunc()
+}
+
+// ByPrNumber orders the results by the pr_number field.
+func ByPrNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrNumber, opts...).ToFunc()
+}
+
+// ByCommitSha orders the results by the commit_sha field.
+func ByCommitSha(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitSha, opts...).ToFunc()
+}
+
+// ByProjectType orders the results by the project_type field.
+func ByProjectType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectType, opts...).ToFunc()
+}
+
+// ByBuildDir orders the results by the build_dir field.
+func ByBuildDir(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildDir, opts...).ToFunc()
+}
+
+// ByCacheStatus orders the results by the cache_status field.
+func ByCacheStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheStatus, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByProjectField orders the results by project field.
+func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProjectStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProjectStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProjectInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+	)
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
@@ -0,0 +1,1112 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deployment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/RajBhut/go-basics/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldID, id))
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldOwner, v))
+}
+
+// Repo applies equality check predicate on the "repo" field. It's identical to RepoEQ.
+func Repo(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldRepo, v))
+}
+
+// Ref applies equality check predicate on the "ref" field. It's identical to RefEQ.
+func Ref(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldRef, v))
+}
+
+// Environment applies equality check predicate on the "environment" field. It's identical to EnvironmentEQ.
+func Environment(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldEnvironment, v))
+}
+
+// PrNumber applies equality check predicate on the "pr_number" field. It's identical to PrNumberEQ.
+func PrNumber(v int) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldPrNumber, v))
+}
+
+// CommitSha applies equality check predicate on the "commit_sha" field. It's identical to CommitShaEQ.
+func CommitSha(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldCommitSha, v))
+}
+
+// ProjectType applies equality check predicate on the "project_type" field. It's identical to ProjectTypeEQ.
+func ProjectType(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldProjectType, v))
+}
+
+// BuildDir applies equality check predicate on the "build_dir" field. It's identical to BuildDirEQ.
+func BuildDir(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldBuildDir, v))
+}
+
+// CacheStatus applies equality check predicate on the "cache_status" field. It's identical to CacheStatusEQ.
+func CacheStatus(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldCacheStatus, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldURL, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldStatus, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldOwner, v))
+}
+
+// RepoEQ applies the EQ predicate on the "repo" field.
+func RepoEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldRepo, v))
+}
+
+// RepoNEQ applies the NEQ predicate on the "repo" field.
+func RepoNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldRepo, v))
+}
+
+// RepoIn applies the In predicate on the "repo" field.
+func RepoIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldRepo, vs...))
+}
+
+// RepoNotIn applies the NotIn predicate on the "repo" field.
+func RepoNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldRepo, vs...))
+}
+
+// RepoGT applies the GT predicate on the "repo" field.
+func RepoGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldRepo, v))
+}
+
+// RepoGTE applies the GTE predicate on the "repo" field.
+func RepoGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldRepo, v))
+}
+
+// RepoLT applies the LT predicate on the "repo" field.
+func RepoLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldRepo, v))
+}
+
+// RepoLTE applies the LTE predicate on the "repo" field.
+func RepoLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldRepo, v))
+}
+
+// RepoContains applies the Contains predicate on the "repo" field.
+func RepoContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldRepo, v))
+}
+
+// RepoHasPrefix applies the HasPrefix predicate on the "repo" field.
+func RepoHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldRepo, v))
+}
+
+// RepoHasSuffix applies the HasSuffix predicate on the "repo" field.
+func RepoHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldRepo, v))
+}
+
+// RepoEqualFold applies the EqualFold predicate on the "repo" field.
+func RepoEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldRepo, v))
+}
+
+// RepoContainsFold applies the ContainsFold predicate on the "repo" field.
+func RepoContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldRepo, v))
+}
+
+// RefEQ applies the EQ predicate on the "ref" field.
+func RefEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldRef, v))
+}
+
+// RefNEQ applies the NEQ predicate on the "ref" field.
+func RefNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldRef, v))
+}
+
+// RefIn applies the In predicate on the "ref" field.
+func RefIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldRef, vs...))
+}
+
+// RefNotIn applies the NotIn predicate on the "ref" field.
+func RefNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldRef, vs...))
+}
+
+// RefGT applies the GT predicate on the "ref" field.
+func RefGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldRef, v))
+}
+
+// RefGTE applies the GTE predicate on the "ref" field.
+func RefGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldRef, v))
+}
+
+// RefLT applies the LT predicate on the "ref" field.
+func RefLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldRef, v))
+}
+
+// RefLTE applies the LTE predicate on the "ref" field.
+func RefLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldRef, v))
+}
+
+// RefContains applies the Contains predicate on the "ref" field.
+func RefContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldRef, v))
+}
+
+// RefHasPrefix applies the HasPrefix predicate on the "ref" field.
+func RefHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldRef, v))
+}
+
+// RefHasSuffix applies the HasSuffix predicate on the "ref" field.
+func RefHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldRef, v))
+}
+
+// RefIsNil applies the IsNil predicate on the "ref" field.
+func RefIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldRef))
+}
+
+// RefNotNil applies the NotNil predicate on the "ref" field.
+func RefNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldRef))
+}
+
+// RefEqualFold applies the EqualFold predicate on the "ref" field.
+func RefEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldRef, v))
+}
+
+// RefContainsFold applies the ContainsFold predicate on the "ref" field.
+func RefContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldRef, v))
+}
+
+// EnvironmentEQ applies the EQ predicate on the "environment" field.
+func EnvironmentEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldEnvironment, v))
+}
+
+// EnvironmentNEQ applies the NEQ predicate on the "environment" field.
+func EnvironmentNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldEnvironment, v))
+}
+
+// EnvironmentIn applies the In predicate on the "environment" field.
+func EnvironmentIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldEnvironment, vs...))
+}
+
+// EnvironmentNotIn applies the NotIn predicate on the "environment" field.
+func EnvironmentNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldEnvironment, vs...))
+}
+
+// EnvironmentGT applies the GT predicate on the "environment" field.
+func EnvironmentGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldEnvironment, v))
+}
+
+// EnvironmentGTE applies the GTE predicate on the "environment" field.
+func EnvironmentGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldEnvironment, v))
+}
+
+// EnvironmentLT applies the LT predicate on the "environment" field.
+func EnvironmentLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldEnvironment, v))
+}
+
+// EnvironmentLTE applies the LTE predicate on the "environment" field.
+func EnvironmentLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldEnvironment, v))
+}
+
+// EnvironmentContains applies the Contains predicate on the "environment" field.
+func EnvironmentContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldEnvironment, v))
+}
+
+// EnvironmentHasPrefix applies the HasPrefix predicate on the "environment" field.
+func EnvironmentHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldEnvironment, v))
+}
+
+// EnvironmentHasSuffix applies the HasSuffix predicate on the "environment" field.
+func EnvironmentHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldEnvironment, v))
+}
+
+// EnvironmentEqualFold applies the EqualFold predicate on the "environment" field.
+func EnvironmentEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldEnvironment, v))
+}
+
+// EnvironmentContainsFold applies the ContainsFold predicate on the "environment" field.
+func EnvironmentContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldEnvironment, v))
+}
+
+// PrNumberEQ applies the EQ predicate on the "pr_number" field.
+func PrNumberEQ(v int) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldPrNumber, v))
+}
+
+// PrNumberNEQ applies the NEQ predicate on the "pr_number" field.
+func PrNumberNEQ(v int) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldPrNumber, v))
+}
+
+// PrNumberIn applies the In predicate on the "pr_number" field.
+func PrNumberIn(vs ...int) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldPrNumber, vs...))
+}
+
+// PrNumberNotIn applies the NotIn predicate on the "pr_number" field.
+func PrNumberNotIn(vs ...int) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldPrNumber, vs...))
+}
+
+// PrNumberGT applies the GT predicate on the "pr_number" field.
+func PrNumberGT(v int) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldPrNumber, v))
+}
+
+// PrNumberGTE applies the GTE predicate on the "pr_number" field.
+func PrNumberGTE(v int) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldPrNumber, v))
+}
+
+// PrNumberLT applies the LT predicate on the "pr_number" field.
+func PrNumberLT(v int) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldPrNumber, v))
+}
+
+// PrNumberLTE applies the LTE predicate on the "pr_number" field.
+func PrNumberLTE(v int) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldPrNumber, v))
+}
+
+// PrNumberIsNil applies the IsNil predicate on the "pr_number" field.
+func PrNumberIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldPrNumber))
+}
+
+// PrNumberNotNil applies the NotNil predicate on the "pr_number" field.
+func PrNumberNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldPrNumber))
+}
+
+// CommitShaEQ applies the EQ predicate on the "commit_sha" field.
+func CommitShaEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldCommitSha, v))
+}
+
+// CommitShaNEQ applies the NEQ predicate on the "commit_sha" field.
+func CommitShaNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldCommitSha, v))
+}
+
+// CommitShaIn applies the In predicate on the "commit_sha" field.
+func CommitShaIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldCommitSha, vs...))
+}
+
+// CommitShaNotIn applies the NotIn predicate on the "commit_sha" field.
+func CommitShaNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldCommitSha, vs...))
+}
+
+// CommitShaGT applies the GT predicate on the "commit_sha" field.
+func CommitShaGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldCommitSha, v))
+}
+
+// CommitShaGTE applies the GTE predicate on the "commit_sha" field.
+func CommitShaGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldCommitSha, v))
+}
+
+// CommitShaLT applies the LT predicate on the "commit_sha" field.
+func CommitShaLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldCommitSha, v))
+}
+
+// CommitShaLTE applies the LTE predicate on the "commit_sha" field.
+func CommitShaLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldCommitSha, v))
+}
+
+// CommitShaContains applies the Contains predicate on the "commit_sha" field.
+func CommitShaContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldCommitSha, v))
+}
+
+// CommitShaHasPrefix applies the HasPrefix predicate on the "commit_sha" field.
+func CommitShaHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldCommitSha, v))
+}
+
+// CommitShaHasSuffix applies the HasSuffix predicate on the "commit_sha" field.
+func CommitShaHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldCommitSha, v))
+}
+
+// CommitShaIsNil applies the IsNil predicate on the "commit_sha" field.
+func CommitShaIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldCommitSha))
+}
+
+// CommitShaNotNil applies the NotNil predicate on the "commit_sha" field.
+func CommitShaNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldCommitSha))
+}
+
+// CommitShaEqualFold applies the EqualFold predicate on the "commit_sha" field.
+func CommitShaEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldCommitSha, v))
+}
+
+// CommitShaContainsFold applies the ContainsFold predicate on the "commit_sha" field.
+func CommitShaContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldCommitSha, v))
+}
+
+// ProjectTypeEQ applies the EQ predicate on the "project_type" field.
+func ProjectTypeEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldProjectType, v))
+}
+
+// ProjectTypeNEQ applies the NEQ predicate on the "project_type" field.
+func ProjectTypeNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldProjectType, v))
+}
+
+// ProjectTypeIn applies the In predicate on the "project_type" field.
+func ProjectTypeIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldProjectType, vs...))
+}
+
+// ProjectTypeNotIn applies the NotIn predicate on the "project_type" field.
+func ProjectTypeNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldProjectType, vs...))
+}
+
+// ProjectTypeGT applies the GT predicate on the "project_type" field.
+func ProjectTypeGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldProjectType, v))
+}
+
+// ProjectTypeGTE applies the GTE predicate on the "project_type" field.
+func ProjectTypeGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldProjectType, v))
+}
+
+// ProjectTypeLT applies the LT predicate on the "project_type" field.
+func ProjectTypeLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldProjectType, v))
+}
+
+// ProjectTypeLTE applies the LTE predicate on the "project_type" field.
+func ProjectTypeLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldProjectType, v))
+}
+
+// ProjectTypeContains applies the Contains predicate on the "project_type" field.
+func ProjectTypeContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldProjectType, v))
+}
+
+// ProjectTypeHasPrefix applies the HasPrefix predicate on the "project_type" field.
+func ProjectTypeHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldProjectType, v))
+}
+
+// ProjectTypeHasSuffix applies the HasSuffix predicate on the "project_type" field.
+func ProjectTypeHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldProjectType, v))
+}
+
+// ProjectTypeIsNil applies the IsNil predicate on the "project_type" field.
+func ProjectTypeIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldProjectType))
+}
+
+// ProjectTypeNotNil applies the NotNil predicate on the "project_type" field.
+func ProjectTypeNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldProjectType))
+}
+
+// ProjectTypeEqualFold applies the EqualFold predicate on the "project_type" field.
+func ProjectTypeEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldProjectType, v))
+}
+
+// ProjectTypeContainsFold applies the ContainsFold predicate on the "project_type" field.
+func ProjectTypeContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldProjectType, v))
+}
+
+// BuildDirEQ applies the EQ predicate on the "build_dir" field.
+func BuildDirEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldBuildDir, v))
+}
+
+// BuildDirNEQ applies the NEQ predicate on the "build_dir" field.
+func BuildDirNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldBuildDir, v))
+}
+
+// BuildDirIn applies the In predicate on the "build_dir" field.
+func BuildDirIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldBuildDir, vs...))
+}
+
+// BuildDirNotIn applies the NotIn predicate on the "build_dir" field.
+func BuildDirNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldBuildDir, vs...))
+}
+
+// BuildDirGT applies the GT predicate on the "build_dir" field.
+func BuildDirGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldBuildDir, v))
+}
+
+// BuildDirGTE applies the GTE predicate on the "build_dir" field.
+func BuildDirGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldBuildDir, v))
+}
+
+// BuildDirLT applies the LT predicate on the "build_dir" field.
+func BuildDirLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldBuildDir, v))
+}
+
+// BuildDirLTE applies the LTE predicate on the "build_dir" field.
+func BuildDirLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldBuildDir, v))
+}
+
+// BuildDirContains applies the Contains predicate on the "build_dir" field.
+func BuildDirContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldBuildDir, v))
+}
+
+// BuildDirHasPrefix applies the HasPrefix predicate on the "build_dir" field.
+func BuildDirHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldBuildDir, v))
+}
+
+// BuildDirHasSuffix applies the HasSuffix predicate on the "build_dir" field.
+func BuildDirHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldBuildDir, v))
+}
+
+// BuildDirIsNil applies the IsNil predicate on the "build_dir" field.
+func BuildDirIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldBuildDir))
+}
+
+// BuildDirNotNil applies the NotNil predicate on the "build_dir" field.
+func BuildDirNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldBuildDir))
+}
+
+// BuildDirEqualFold applies the EqualFold predicate on the "build_dir" field.
+func BuildDirEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldBuildDir, v))
+}
+
+// BuildDirContainsFold applies the ContainsFold predicate on the "build_dir" field.
+func BuildDirContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldBuildDir, v))
+}
+
+// CacheStatusEQ applies the EQ predicate on the "cache_status" field.
+func CacheStatusEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldCacheStatus, v))
+}
+
+// CacheStatusNEQ applies the NEQ predicate on the "cache_status" field.
+func CacheStatusNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldCacheStatus, v))
+}
+
+// CacheStatusIn applies the In predicate on the "cache_status" field.
+func CacheStatusIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldCacheStatus, vs...))
+}
+
+// CacheStatusNotIn applies the NotIn predicate on the "cache_status" field.
+func CacheStatusNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldCacheStatus, vs...))
+}
+
+// CacheStatusGT applies the GT predicate on the "cache_status" field.
+func CacheStatusGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldCacheStatus, v))
+}
+
+// CacheStatusGTE applies the GTE predicate on the "cache_status" field.
+func CacheStatusGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldCacheStatus, v))
+}
+
+// CacheStatusLT applies the LT predicate on the "cache_status" field.
+func CacheStatusLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldCacheStatus, v))
+}
+
+// CacheStatusLTE applies the LTE predicate on the "cache_status" field.
+func CacheStatusLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldCacheStatus, v))
+}
+
+// CacheStatusContains applies the Contains predicate on the "cache_status" field.
+func CacheStatusContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldCacheStatus, v))
+}
+
+// CacheStatusHasPrefix applies the HasPrefix predicate on the "cache_status" field.
+func CacheStatusHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldCacheStatus, v))
+}
+
+// CacheStatusHasSuffix applies the HasSuffix predicate on the "cache_status" field.
+func CacheStatusHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldCacheStatus, v))
+}
+
+// CacheStatusIsNil applies the IsNil predicate on the "cache_status" field.
+func CacheStatusIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldCacheStatus))
+}
+
+// CacheStatusNotNil applies the NotNil predicate on the "cache_status" field.
+func CacheStatusNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldCacheStatus))
+}
+
+// CacheStatusEqualFold applies the EqualFold predicate on the "cache_status" field.
+func CacheStatusEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldCacheStatus, v))
+}
+
+// CacheStatusContainsFold applies the ContainsFold predicate on the "cache_status" field.
+func CacheStatusContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldCacheStatus, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLIsNil applies the IsNil predicate on the "url" field.
+func URLIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldURL))
+}
+
+// URLNotNil applies the NotNil predicate on the "url" field.
+func URLNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldURL))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldURL, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.Deployment {
+	return predicate.Deployment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.Deployment {
+	return predicate.Deployment(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Deployment {
+	return predicate.Deployment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Deployment {
+	return predicate.Deployment(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Deployment) predicate.Deployment {
+	return predicate.Deployment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Deployment) predicate.Deployment {
+	return predicate.Deployment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Deployment) predicate.Deployment {
+	return predicate.Deployment(sql.NotPredicates(p))
+}
@@ -0,0 +1,530 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/RajBhut/go-basics/ent/deployment"
+	"github.com/RajBhut/go-basics/ent/project"
+	"github.com/RajBhut/go-basics/ent/user"
+)
+
+// DeploymentCreate is the builder for creating a Deployment entity.
+type DeploymentCreate struct {
+	config
+	mutation *DeploymentMutation
+	hooks    []Hook
+}
+
+// SetOwner sets the "owner" field.
+func (dc *DeploymentCreate) SetOwner(s string) *DeploymentCreate {
+	dc.mutation.SetOwner(s)
+	return dc
+}
+
+// SetRepo sets the "repo" field.
+func (dc *DeploymentCreate) SetRepo(s string) *DeploymentCreate {
+	dc.mutation.SetRepo(s)
+	return dc
+}
+
+// SetRef sets the "ref" field.
+func (dc *DeploymentCreate) SetRef(s string) *DeploymentCreate {
+	dc.mutation.SetRef(s)
+	return dc
+}
+
+// SetNillableRef sets the "ref" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableRef(s *string) *DeploymentCreate {
+	if s != nil {
+		dc.SetRef(*s)
+	}
+	return dc
+}
+
+// SetEnvironment sets the "environment" field.
+func (dc *DeploymentCreate) SetEnvironment(s string) *DeploymentCreate {
+	dc.mutation.SetEnvironment(s)
+	return dc
+}
+
+// SetNillableEnvironment sets the "environment" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableEnvironment(s *string) *DeploymentCreate {
+	if s != nil {
+		dc.SetEnvironment(*s)
+	}
+	return dc
+}
+
+// SetPrNumber sets the "pr_number" field.
+func (dc *DeploymentCreate) SetPrNumber(i int) *DeploymentCreate {
+	dc.mutation.SetPrNumber(i)
+	return dc
+}
+
+// SetNillablePrNumber sets the "pr_number" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillablePrNumber(i *int) *DeploymentCreate {
+	if i != nil {
+		dc.SetPrNumber(*i)
+	}
+	return dc
+}
+
+// SetCommitSha sets the "commit_sha" field.
+func (dc *DeploymentCreate) SetCommitSha(s string) *DeploymentCreate {
+	dc.mutation.SetCommitSha(s)
+	return dc
+}
+
+// SetNillableCommitSha sets the "commit_sha" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableCommitSha(s *string) *DeploymentCreate {
+	if s != nil {
+		dc.SetCommitSha(*s)
+	}
+	return dc
+}
+
+// SetProjectType sets the "project_type" field.
+func (dc *DeploymentCreate) SetProjectType(s string) *DeploymentCreate {
+	dc.mutation.SetProjectType(s)
+	return dc
+}
+
+// SetNillableProjectType sets the "project_type" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableProjectType(s *string) *DeploymentCreate {
+	if s != nil {
+		dc.SetProjectType(*s)
+	}
+	return dc
+}
+
+// SetBuildDir sets the "build_dir" field.
+func (dc *DeploymentCreate) SetBuildDir(s string) *DeploymentCreate {
+	dc.mutation.SetBuildDir(s)
+	return dc
+}
+
+// SetNillableBuildDir sets the "build_dir" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableBuildDir(s *string) *DeploymentCreate {
+	if s != nil {
+		dc.SetBuildDir(*s)
+	}
+	return dc
+}
+
+// SetCacheStatus sets the "cache_status" field.
+func (dc *DeploymentCreate) SetCacheStatus(s string) *DeploymentCreate {
+	dc.mutation.SetCacheStatus(s)
+	return dc
+}
+
+// SetNillableCacheStatus sets the "cache_status" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableCacheStatus(s *string) *DeploymentCreate {
+	if s != nil {
+		dc.SetCacheStatus(*s)
+	}
+	return dc
+}
+
+// SetURL sets the "url" field.
+func (dc *DeploymentCreate) SetURL(s string) *DeploymentCreate {
+	dc.mutation.SetURL(s)
+	return dc
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableURL(s *string) *DeploymentCreate {
+	if s != nil {
+		dc.SetURL(*s)
+	}
+	return dc
+}
+
+// SetStatus sets the "status" field.
+func (dc *DeploymentCreate) SetStatus(s string) *DeploymentCreate {
+	dc.mutation.SetStatus(s)
+	return dc
+}
+
+// SetError sets the "error" field.
+func (dc *DeploymentCreate) SetError(s string) *DeploymentCreate {
+	dc.mutation.SetError(s)
+	return dc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableError(s *string) *DeploymentCreate {
+	if s != nil {
+		dc.SetError(*s)
+	}
+	return dc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dc *DeploymentCreate) SetCreatedAt(t time.Time) *DeploymentCreate {
+	dc.mutation.SetCreatedAt(t)
+	return dc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableCreatedAt(t *time.Time) *DeploymentCreate {
+	if t != nil {
+		dc.SetCreatedAt(*t)
+	}
+	return dc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dc *DeploymentCreate) SetUpdatedAt(t time.Time) *DeploymentCreate {
+	dc.mutation.SetUpdatedAt(t)
+	return dc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableUpdatedAt(t *time.Time) *DeploymentCreate {
+	if t != nil {
+		dc.SetUpdatedAt(*t)
+	}
+	return dc
+}
+
+// SetID sets the "id" field.
+func (dc *DeploymentCreate) SetID(s string) *DeploymentCreate {
+	dc.mutation.SetID(s)
+	return dc
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (dc *DeploymentCreate) SetProjectID(id int) *DeploymentCreate {
+	dc.mutation.SetProjectID(id)
+	return dc
+}
+
+// SetNillableProjectID sets the "project" edge to the Project entity by ID if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableProjectID(id *int) *DeploymentCreate {
+	if id != nil {
+		dc = dc.SetProjectID(*id)
+	}
+	return dc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (dc *DeploymentCreate) SetProject(p *Project) *DeploymentCreate {
+	return dc.SetProjectID(p.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (dc *DeploymentCreate) SetUserID(id int) *DeploymentCreate {
+	dc.mutation.SetUserID(id)
+	return dc
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableUserID(id *int) *DeploymentCreate {
+	if id != nil {
+		dc = dc.SetUserID(*id)
+	}
+	return dc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dc *DeploymentCreate) SetUser(u *User) *DeploymentCreate {
+	return dc.SetUserID(u.ID)
+}
+
+// Mutation returns the DeploymentMutation object of the builder.
+func (dc *DeploymentCreate) Mutation() *DeploymentMutation {
+	return dc.mutation
+}
+
+// Save creates the Deployment in the database.
+func (dc *DeploymentCreate) Save(ctx context.Context) (*Deployment, error) {
+	dc.defaults()
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DeploymentCreate) SaveX(ctx context.Context) *Deployment {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DeploymentCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DeploymentCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DeploymentCreate) defaults() {
+	if _, ok := dc.mutation.Environment(); !ok {
+		v := deployment.DefaultEnvironment
+		dc.mutation.SetEnvironment(v)
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		v := deployment.DefaultCreatedAt()
+		dc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		v := deployment.DefaultUpdatedAt()
+		dc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DeploymentCreate) check() error {
+	if _, ok := dc.mutation.Owner(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required field "Deployment.owner"`)}
+	}
+	if v, ok := dc.mutation.Owner(); ok {
+		if err := deployment.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Deployment.owner": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Repo(); !ok {
+		return &ValidationError{Name: "repo", err: errors.New(`ent: missing required field "Deployment.repo"`)}
+	}
+	if v, ok := dc.mutation.Repo(); ok {
+		if err := deployment.RepoValidator(v); err != nil {
+			return &ValidationError{Name: "repo", err: fmt.Errorf(`ent: validator failed for field "Deployment.repo": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Environment(); !ok {
+		return &ValidationError{Name: "environment", err: errors.New(`ent: missing required field "Deployment.environment"`)}
+	}
+	if _, ok := dc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Deployment.status"`)}
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Deployment.created_at"`)}
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Deployment.updated_at"`)}
+	}
+	return nil
+}
+
+func (dc *DeploymentCreate) sqlSave(ctx context.Context) (*Deployment, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Deployment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DeploymentCreate) createSpec() (*Deployment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Deployment{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(deployment.Table, sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString))
+	)
+	if id, ok := dc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := dc.mutation.Owner(); ok {
+		_spec.SetField(deployment.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := dc.mutation.Repo(); ok {
+		_spec.SetField(deployment.FieldRepo, field.TypeString, value)
+		_node.Repo = value
+	}
+	if value, ok := dc.mutation.Ref(); ok {
+		_spec.SetField(deployment.FieldRef, field.TypeString, value)
+		_node.Ref = value
+	}
+	if value, ok := dc.mutation.Environment(); ok {
+		_spec.SetField(deployment.FieldEnvironment, field.TypeString, value)
+		_node.Environment = value
+	}
+	if value, ok := dc.mutation.PrNumber(); ok {
+		_spec.SetField(deployment.FieldPrNumber, field.TypeInt, value)
+		_node.PrNumber = value
+	}
+	if value, ok := dc.mutation.CommitSha(); ok {
+		_spec.SetField(deployment.FieldCommitSha, field.TypeString, value)
+		_node.CommitSha = value
+	}
+	if value, ok := dc.mutation.ProjectType(); ok {
+		_spec.SetField(deployment.FieldProjectType, field.TypeString, value)
+		_node.ProjectType = value
+	}
+	if value, ok := dc.mutation.BuildDir(); ok {
+		_spec.SetField(deployment.FieldBuildDir, field.TypeString, value)
+		_node.BuildDir = value
+	}
+	if value, ok := dc.mutation.CacheStatus(); ok {
+		_spec.SetField(deployment.FieldCacheStatus, field.TypeString, value)
+		_node.CacheStatus = value
+	}
+	if value, ok := dc.mutation.URL(); ok {
+		_spec.SetField(deployment.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := dc.mutation.Status(); ok {
+		_spec.SetField(deployment.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := dc.mutation.Error(); ok {
+		_spec.SetField(deployment.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := dc.mutation.CreatedAt(); ok {
+		_spec.SetField(deployment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dc.mutation.UpdatedAt(); ok {
+		_spec.SetField(deployment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := dc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.ProjectTable,
+			Columns: []string{deployment.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.project_deployments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.UserTable,
+			Columns: []string{deployment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_deployments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DeploymentCreateBulk is the builder for creating many Deployment entities in bulk.
+type DeploymentCreateBulk struct {
+	config
+	err      error
+	builders []*DeploymentCreate
+}
+
+// Save creates the Deployment entities in the database.
+func (dcb *DeploymentCreateBulk) Save(ctx context.Context) ([]*Deployment, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Deployment, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeploymentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DeploymentCreateBulk) SaveX(ctx context.Context) []*Deployment {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DeploymentCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DeploymentCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
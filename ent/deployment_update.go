@@ -0,0 +1,1038 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/RajBhut/go-basics/ent/deployment"
+	"github.com/RajBhut/go-basics/ent/predicate"
+	"github.com/RajBhut/go-basics/ent/project"
+	"github.com/RajBhut/go-basics/ent/user"
+)
+
+// DeploymentUpdate is the builder for updating Deployment entities.
+type DeploymentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeploymentMutation
+}
+
+// Where appends a list predicates to the DeploymentUpdate builder.
+func (du *DeploymentUpdate) Where(ps ...predicate.Deployment) *DeploymentUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetOwner sets the "owner" field.
+func (du *DeploymentUpdate) SetOwner(s string) *DeploymentUpdate {
+	du.mutation.SetOwner(s)
+	return du
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableOwner(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetOwner(*s)
+	}
+	return du
+}
+
+// SetRepo sets the "repo" field.
+func (du *DeploymentUpdate) SetRepo(s string) *DeploymentUpdate {
+	du.mutation.SetRepo(s)
+	return du
+}
+
+// SetNillableRepo sets the "repo" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableRepo(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetRepo(*s)
+	}
+	return du
+}
+
+// SetRef sets the "ref" field.
+func (du *DeploymentUpdate) SetRef(s string) *DeploymentUpdate {
+	du.mutation.SetRef(s)
+	return du
+}
+
+// SetNillableRef sets the "ref" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableRef(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetRef(*s)
+	}
+	return du
+}
+
+// ClearRef clears the value of the "ref" field.
+func (du *DeploymentUpdate) ClearRef() *DeploymentUpdate {
+	du.mutation.ClearRef()
+	return du
+}
+
+// SetEnvironment sets the "environment" field.
+func (du *DeploymentUpdate) SetEnvironment(s string) *DeploymentUpdate {
+	du.mutation.SetEnvironment(s)
+	return du
+}
+
+// SetNillableEnvironment sets the "environment" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableEnvironment(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetEnvironment(*s)
+	}
+	return du
+}
+
+// SetPrNumber sets the "pr_number" field.
+func (du *DeploymentUpdate) SetPrNumber(i int) *DeploymentUpdate {
+	du.mutation.ResetPrNumber()
+	du.mutation.SetPrNumber(i)
+	return du
+}
+
+// SetNillablePrNumber sets the "pr_number" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillablePrNumber(i *int) *DeploymentUpdate {
+	if i != nil {
+		du.SetPrNumber(*i)
+	}
+	return du
+}
+
+// AddPrNumber adds i to the "pr_number" field.
+func (du *DeploymentUpdate) AddPrNumber(i int) *DeploymentUpdate {
+	du.mutation.AddPrNumber(i)
+	return du
+}
+
+// ClearPrNumber clears the value of the "pr_number" field.
+func (du *DeploymentUpdate) ClearPrNumber() *DeploymentUpdate {
+	du.mutation.ClearPrNumber()
+	return du
+}
+
+// SetCommitSha sets the "commit_sha" field.
+func (du *DeploymentUpdate) SetCommitSha(s string) *DeploymentUpdate {
+	du.mutation.SetCommitSha(s)
+	return du
+}
+
+// SetNillableCommitSha sets the "commit_sha" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableCommitSha(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetCommitSha(*s)
+	}
+	return du
+}
+
+// ClearCommitSha clears the value of the "commit_sha" field.
+func (du *DeploymentUpdate) ClearCommitSha() *DeploymentUpdate {
+	du.mutation.ClearCommitSha()
+	return du
+}
+
+// SetProjectType sets the "project_type" field.
+func (du *DeploymentUpdate) SetProjectType(s string) *DeploymentUpdate {
+	du.mutation.SetProjectType(s)
+	return du
+}
+
+// SetNillableProjectType sets the "project_type" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableProjectType(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetProjectType(*s)
+	}
+	return du
+}
+
+// ClearProjectType clears the value of the "project_type" field.
+func (du *DeploymentUpdate) ClearProjectType() *DeploymentUpdate {
+	du.mutation.ClearProjectType()
+	return du
+}
+
+// SetBuildDir sets the "build_dir" field.
+func (du *DeploymentUpdate) SetBuildDir(s string) *DeploymentUpdate {
+	du.mutation.SetBuildDir(s)
+	return du
+}
+
+// SetNillableBuildDir sets the "build_dir" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableBuildDir(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetBuildDir(*s)
+	}
+	return du
+}
+
+// ClearBuildDir clears the value of the "build_dir" field.
+func (du *DeploymentUpdate) ClearBuildDir() *DeploymentUpdate {
+	du.mutation.ClearBuildDir()
+	return du
+}
+
+// SetCacheStatus sets the "cache_status" field.
+func (du *DeploymentUpdate) SetCacheStatus(s string) *DeploymentUpdate {
+	du.mutation.SetCacheStatus(s)
+	return du
+}
+
+// SetNillableCacheStatus sets the "cache_status" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableCacheStatus(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetCacheStatus(*s)
+	}
+	return du
+}
+
+// ClearCacheStatus clears the value of the "cache_status" field.
+func (du *DeploymentUpdate) ClearCacheStatus() *DeploymentUpdate {
+	du.mutation.ClearCacheStatus()
+	return du
+}
+
+// SetURL sets the "url" field.
+func (du *DeploymentUpdate) SetURL(s string) *DeploymentUpdate {
+	du.mutation.SetURL(s)
+	return du
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableURL(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetURL(*s)
+	}
+	return du
+}
+
+// ClearURL clears the value of the "url" field.
+func (du *DeploymentUpdate) ClearURL() *DeploymentUpdate {
+	du.mutation.ClearURL()
+	return du
+}
+
+// SetStatus sets the "status" field.
+func (du *DeploymentUpdate) SetStatus(s string) *DeploymentUpdate {
+	du.mutation.SetStatus(s)
+	return du
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableStatus(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetStatus(*s)
+	}
+	return du
+}
+
+// SetError sets the "error" field.
+func (du *DeploymentUpdate) SetError(s string) *DeploymentUpdate {
+	du.mutation.SetError(s)
+	return du
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableError(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetError(*s)
+	}
+	return du
+}
+
+// ClearError clears the value of the "error" field.
+func (du *DeploymentUpdate) ClearError() *DeploymentUpdate {
+	du.mutation.ClearError()
+	return du
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (du *DeploymentUpdate) SetUpdatedAt(t time.Time) *DeploymentUpdate {
+	du.mutation.SetUpdatedAt(t)
+	return du
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (du *DeploymentUpdate) SetProjectID(id int) *DeploymentUpdate {
+	du.mutation.SetProjectID(id)
+	return du
+}
+
+// SetNillableProjectID sets the "project" edge to the Project entity by ID if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableProjectID(id *int) *DeploymentUpdate {
+	if id != nil {
+		du = du.SetProjectID(*id)
+	}
+	return du
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (du *DeploymentUpdate) SetProject(p *Project) *DeploymentUpdate {
+	return du.SetProjectID(p.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (du *DeploymentUpdate) SetUserID(id int) *DeploymentUpdate {
+	du.mutation.SetUserID(id)
+	return du
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableUserID(id *int) *DeploymentUpdate {
+	if id != nil {
+		du = du.SetUserID(*id)
+	}
+	return du
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (du *DeploymentUpdate) SetUser(u *User) *DeploymentUpdate {
+	return du.SetUserID(u.ID)
+}
+
+// Mutation returns the DeploymentMutation object of the builder.
+func (du *DeploymentUpdate) Mutation() *DeploymentMutation {
+	return du.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (du *DeploymentUpdate) ClearProject() *DeploymentUpdate {
+	du.mutation.ClearProject()
+	return du
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (du *DeploymentUpdate) ClearUser() *DeploymentUpdate {
+	du.mutation.ClearUser()
+	return du
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DeploymentUpdate) Save(ctx context.Context) (int, error) {
+	du.defaults()
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DeploymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DeploymentUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DeploymentUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (du *DeploymentUpdate) defaults() {
+	if _, ok := du.mutation.UpdatedAt(); !ok {
+		v := deployment.UpdateDefaultUpdatedAt()
+		du.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DeploymentUpdate) check() error {
+	if v, ok := du.mutation.Owner(); ok {
+		if err := deployment.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Deployment.owner": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.Repo(); ok {
+		if err := deployment.RepoValidator(v); err != nil {
+			return &ValidationError{Name: "repo", err: fmt.Errorf(`ent: validator failed for field "Deployment.repo": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (du *DeploymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := du.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deployment.Table, deployment.Columns, sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.Owner(); ok {
+		_spec.SetField(deployment.FieldOwner, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Repo(); ok {
+		_spec.SetField(deployment.FieldRepo, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Ref(); ok {
+		_spec.SetField(deployment.FieldRef, field.TypeString, value)
+	}
+	if du.mutation.RefCleared() {
+		_spec.ClearField(deployment.FieldRef, field.TypeString)
+	}
+	if value, ok := du.mutation.Environment(); ok {
+		_spec.SetField(deployment.FieldEnvironment, field.TypeString, value)
+	}
+	if value, ok := du.mutation.PrNumber(); ok {
+		_spec.SetField(deployment.FieldPrNumber, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.AddedPrNumber(); ok {
+		_spec.AddField(deployment.FieldPrNumber, field.TypeInt, value)
+	}
+	if du.mutation.PrNumberCleared() {
+		_spec.ClearField(deployment.FieldPrNumber, field.TypeInt)
+	}
+	if value, ok := du.mutation.CommitSha(); ok {
+		_spec.SetField(deployment.FieldCommitSha, field.TypeString, value)
+	}
+	if du.mutation.CommitShaCleared() {
+		_spec.ClearField(deployment.FieldCommitSha, field.TypeString)
+	}
+	if value, ok := du.mutation.ProjectType(); ok {
+		_spec.SetField(deployment.FieldProjectType, field.TypeString, value)
+	}
+	if du.mutation.ProjectTypeCleared() {
+		_spec.ClearField(deployment.FieldProjectType, field.TypeString)
+	}
+	if value, ok := du.mutation.BuildDir(); ok {
+		_spec.SetField(deployment.FieldBuildDir, field.TypeString, value)
+	}
+	if du.mutation.BuildDirCleared() {
+		_spec.ClearField(deployment.FieldBuildDir, field.TypeString)
+	}
+	if value, ok := du.mutation.CacheStatus(); ok {
+		_spec.SetField(deployment.FieldCacheStatus, field.TypeString, value)
+	}
+	if du.mutation.CacheStatusCleared() {
+		_spec.ClearField(deployment.FieldCacheStatus, field.TypeString)
+	}
+	if value, ok := du.mutation.URL(); ok {
+		_spec.SetField(deployment.FieldURL, field.TypeString, value)
+	}
+	if du.mutation.URLCleared() {
+		_spec.ClearField(deployment.FieldURL, field.TypeString)
+	}
+	if value, ok := du.mutation.Status(); ok {
+		_spec.SetField(deployment.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Error(); ok {
+		_spec.SetField(deployment.FieldError, field.TypeString, value)
+	}
+	if du.mutation.ErrorCleared() {
+		_spec.ClearField(deployment.FieldError, field.TypeString)
+	}
+	if value, ok := du.mutation.UpdatedAt(); ok {
+		_spec.SetField(deployment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if du.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.ProjectTable,
+			Columns: []string{deployment.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.ProjectTable,
+			Columns: []string{deployment.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.UserTable,
+			Columns: []string{deployment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.UserTable,
+			Columns: []string{deployment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deployment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DeploymentUpdateOne is the builder for updating a single Deployment entity.
+type DeploymentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeploymentMutation
+}
+
+// SetOwner sets the "owner" field.
+func (duo *DeploymentUpdateOne) SetOwner(s string) *DeploymentUpdateOne {
+	duo.mutation.SetOwner(s)
+	return duo
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableOwner(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetOwner(*s)
+	}
+	return duo
+}
+
+// SetRepo sets the "repo" field.
+func (duo *DeploymentUpdateOne) SetRepo(s string) *DeploymentUpdateOne {
+	duo.mutation.SetRepo(s)
+	return duo
+}
+
+// SetNillableRepo sets the "repo" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableRepo(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetRepo(*s)
+	}
+	return duo
+}
+
+// SetRef sets the "ref" field.
+func (duo *DeploymentUpdateOne) SetRef(s string) *DeploymentUpdateOne {
+	duo.mutation.SetRef(s)
+	return duo
+}
+
+// SetNillableRef sets the "ref" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableRef(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetRef(*s)
+	}
+	return duo
+}
+
+// ClearRef clears the value of the "ref" field.
+func (duo *DeploymentUpdateOne) ClearRef() *DeploymentUpdateOne {
+	duo.mutation.ClearRef()
+	return duo
+}
+
+// SetEnvironment sets the "environment" field.
+func (duo *DeploymentUpdateOne) SetEnvironment(s string) *DeploymentUpdateOne {
+	duo.mutation.SetEnvironment(s)
+	return duo
+}
+
+// SetNillableEnvironment sets the "environment" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableEnvironment(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetEnvironment(*s)
+	}
+	return duo
+}
+
+// SetPrNumber sets the "pr_number" field.
+func (duo *DeploymentUpdateOne) SetPrNumber(i int) *DeploymentUpdateOne {
+	duo.mutation.ResetPrNumber()
+	duo.mutation.SetPrNumber(i)
+	return duo
+}
+
+// SetNillablePrNumber sets the "pr_number" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillablePrNumber(i *int) *DeploymentUpdateOne {
+	if i != nil {
+		duo.SetPrNumber(*i)
+	}
+	return duo
+}
+
+// AddPrNumber adds i to the "pr_number" field.
+func (duo *DeploymentUpdateOne) AddPrNumber(i int) *DeploymentUpdateOne {
+	duo.mutation.AddPrNumber(i)
+	return duo
+}
+
+// ClearPrNumber clears the value of the "pr_number" field.
+func (duo *DeploymentUpdateOne) ClearPrNumber() *DeploymentUpdateOne {
+	duo.mutation.ClearPrNumber()
+	return duo
+}
+
+// SetCommitSha sets the "commit_sha" field.
+func (duo *DeploymentUpdateOne) SetCommitSha(s string) *DeploymentUpdateOne {
+	duo.mutation.SetCommitSha(s)
+	return duo
+}
+
+// SetNillableCommitSha sets the "commit_sha" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableCommitSha(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetCommitSha(*s)
+	}
+	return duo
+}
+
+// ClearCommitSha clears the value of the "commit_sha" field.
+func (duo *DeploymentUpdateOne) ClearCommitSha() *DeploymentUpdateOne {
+	duo.mutation.ClearCommitSha()
+	return duo
+}
+
+// SetProjectType sets the "project_type" field.
+func (duo *DeploymentUpdateOne) SetProjectType(s string) *DeploymentUpdateOne {
+	duo.mutation.SetProjectType(s)
+	return duo
+}
+
+// SetNillableProjectType sets the "project_type" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableProjectType(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetProjectType(*s)
+	}
+	return duo
+}
+
+// ClearProjectType clears the value of the "project_type" field.
+func (duo *DeploymentUpdateOne) ClearProjectType() *DeploymentUpdateOne {
+	duo.mutation.ClearProjectType()
+	return duo
+}
+
+// SetBuildDir sets the "build_dir" field.
+func (duo *DeploymentUpdateOne) SetBuildDir(s string) *DeploymentUpdateOne {
+	duo.mutation.SetBuildDir(s)
+	return duo
+}
+
+// SetNillableBuildDir sets the "build_dir" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableBuildDir(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetBuildDir(*s)
+	}
+	return duo
+}
+
+// ClearBuildDir clears the value of the "build_dir" field.
+func (duo *DeploymentUpdateOne) ClearBuildDir() *DeploymentUpdateOne {
+	duo.mutation.ClearBuildDir()
+	return duo
+}
+
+// SetCacheStatus sets the "cache_status" field.
+func (duo *DeploymentUpdateOne) SetCacheStatus(s string) *DeploymentUpdateOne {
+	duo.mutation.SetCacheStatus(s)
+	return duo
+}
+
+// SetNillableCacheStatus sets the "cache_status" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableCacheStatus(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetCacheStatus(*s)
+	}
+	return duo
+}
+
+// ClearCacheStatus clears the value of the "cache_status" field.
+func (duo *DeploymentUpdateOne) ClearCacheStatus() *DeploymentUpdateOne {
+	duo.mutation.ClearCacheStatus()
+	return duo
+}
+
+// SetURL sets the "url" field.
+func (duo *DeploymentUpdateOne) SetURL(s string) *DeploymentUpdateOne {
+	duo.mutation.SetURL(s)
+	return duo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableURL(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetURL(*s)
+	}
+	return duo
+}
+
+// ClearURL clears the value of the "url" field.
+func (duo *DeploymentUpdateOne) ClearURL() *DeploymentUpdateOne {
+	duo.mutation.ClearURL()
+	return duo
+}
+
+// SetStatus sets the "status" field.
+func (duo *DeploymentUpdateOne) SetStatus(s string) *DeploymentUpdateOne {
+	duo.mutation.SetStatus(s)
+	return duo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableStatus(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetStatus(*s)
+	}
+	return duo
+}
+
+// SetError sets the "error" field.
+func (duo *DeploymentUpdateOne) SetError(s string) *DeploymentUpdateOne {
+	duo.mutation.SetError(s)
+	return duo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableError(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetError(*s)
+	}
+	return duo
+}
+
+// ClearError clears the value of the "error" field.
+func (duo *DeploymentUpdateOne) ClearError() *DeploymentUpdateOne {
+	duo.mutation.ClearError()
+	return duo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (duo *DeploymentUpdateOne) SetUpdatedAt(t time.Time) *DeploymentUpdateOne {
+	duo.mutation.SetUpdatedAt(t)
+	return duo
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (duo *DeploymentUpdateOne) SetProjectID(id int) *DeploymentUpdateOne {
+	duo.mutation.SetProjectID(id)
+	return duo
+}
+
+// SetNillableProjectID sets the "project" edge to the Project entity by ID if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableProjectID(id *int) *DeploymentUpdateOne {
+	if id != nil {
+		duo = duo.SetProjectID(*id)
+	}
+	return duo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (duo *DeploymentUpdateOne) SetProject(p *Project) *DeploymentUpdateOne {
+	return duo.SetProjectID(p.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (duo *DeploymentUpdateOne) SetUserID(id int) *DeploymentUpdateOne {
+	duo.mutation.SetUserID(id)
+	return duo
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableUserID(id *int) *DeploymentUpdateOne {
+	if id != nil {
+		duo = duo.SetUserID(*id)
+	}
+	return duo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (duo *DeploymentUpdateOne) SetUser(u *User) *DeploymentUpdateOne {
+	return duo.SetUserID(u.ID)
+}
+
+// Mutation returns the DeploymentMutation object of the builder.
+func (duo *DeploymentUpdateOne) Mutation() *DeploymentMutation {
+	return duo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (duo *DeploymentUpdateOne) ClearProject() *DeploymentUpdateOne {
+	duo.mutation.ClearProject()
+	return duo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (duo *DeploymentUpdateOne) ClearUser() *DeploymentUpdateOne {
+	duo.mutation.ClearUser()
+	return duo
+}
+
+// Where appends a list predicates to the DeploymentUpdate builder.
+func (duo *DeploymentUpdateOne) Where(ps ...predicate.Deployment) *DeploymentUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DeploymentUpdateOne) Select(field string, fields ...string) *DeploymentUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Deployment entity.
+func (duo *DeploymentUpdateOne) Save(ctx context.Context) (*Deployment, error) {
+	duo.defaults()
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DeploymentUpdateOne) SaveX(ctx context.Context) *Deployment {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DeploymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DeploymentUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (duo *DeploymentUpdateOne) defaults() {
+	if _, ok := duo.mutation.UpdatedAt(); !ok {
+		v := deployment.UpdateDefaultUpdatedAt()
+		duo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DeploymentUpdateOne) check() error {
+	if v, ok := duo.mutation.Owner(); ok {
+		if err := deployment.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Deployment.owner": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.Repo(); ok {
+		if err := deployment.RepoValidator(v); err != nil {
+			return &ValidationError{Name: "repo", err: fmt.Errorf(`ent: validator failed for field "Deployment.repo": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (duo *DeploymentUpdateOne) sqlSave(ctx context.Context) (_node *Deployment, err error) {
+	if err := duo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deployment.Table, deployment.Columns, sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Deployment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deployment.FieldID)
+		for _, f := range fields {
+			if !deployment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deployment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.Owner(); ok {
+		_spec.SetField(deployment.FieldOwner, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Repo(); ok {
+		_spec.SetField(deployment.FieldRepo, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Ref(); ok {
+		_spec.SetField(deployment.FieldRef, field.TypeString, value)
+	}
+	if duo.mutation.RefCleared() {
+		_spec.ClearField(deployment.FieldRef, field.TypeString)
+	}
+	if value, ok := duo.mutation.Environment(); ok {
+		_spec.SetField(deployment.FieldEnvironment, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.PrNumber(); ok {
+		_spec.SetField(deployment.FieldPrNumber, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.AddedPrNumber(); ok {
+		_spec.AddField(deployment.FieldPrNumber, field.TypeInt, value)
+	}
+	if duo.mutation.PrNumberCleared() {
+		_spec.ClearField(deployment.FieldPrNumber, field.TypeInt)
+	}
+	if value, ok := duo.mutation.CommitSha(); ok {
+		_spec.SetField(deployment.FieldCommitSha, field.TypeString, value)
+	}
+	if duo.mutation.CommitShaCleared() {
+		_spec.ClearField(deployment.FieldCommitSha, field.TypeString)
+	}
+	if value, ok := duo.mutation.ProjectType(); ok {
+		_spec.SetField(deployment.FieldProjectType, field.TypeString, value)
+	}
+	if duo.mutation.ProjectTypeCleared() {
+		_spec.ClearField(deployment.FieldProjectType, field.TypeString)
+	}
+	if value, ok := duo.mutation.BuildDir(); ok {
+		_spec.SetField(deployment.FieldBuildDir, field.TypeString, value)
+	}
+	if duo.mutation.BuildDirCleared() {
+		_spec.ClearField(deployment.FieldBuildDir, field.TypeString)
+	}
+	if value, ok := duo.mutation.CacheStatus(); ok {
+		_spec.SetField(deployment.FieldCacheStatus, field.TypeString, value)
+	}
+	if duo.mutation.CacheStatusCleared() {
+		_spec.ClearField(deployment.FieldCacheStatus, field.TypeString)
+	}
+	if value, ok := duo.mutation.URL(); ok {
+		_spec.SetField(deployment.FieldURL, field.TypeString, value)
+	}
+	if duo.mutation.URLCleared() {
+		_spec.ClearField(deployment.FieldURL, field.TypeString)
+	}
+	if value, ok := duo.mutation.Status(); ok {
+		_spec.SetField(deployment.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Error(); ok {
+		_spec.SetField(deployment.FieldError, field.TypeString, value)
+	}
+	if duo.mutation.ErrorCleared() {
+		_spec.ClearField(deployment.FieldError, field.TypeString)
+	}
+	if value, ok := duo.mutation.UpdatedAt(); ok {
+		_spec.SetField(deployment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if duo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.ProjectTable,
+			Columns: []string{deployment.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.ProjectTable,
+			Columns: []string{deployment.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.UserTable,
+			Columns: []string{deployment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.UserTable,
+			Columns: []string{deployment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Deployment{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deployment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/RajBhut/go-basics/ent/domain"
+	"github.com/RajBhut/go-basics/ent/project"
+)
+
+// Domain is the model entity for the Domain schema.
+type Domain struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Hostname holds the value of the "hostname" field.
+	Hostname string `json:"hostname,omitempty"`
+	// Method holds the value of the "method" field.
+	Method domain.Method `json:"method,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"-"`
+	// Verified holds the value of the "verified" field.
+	Verified bool `json:"verified,omitempty"`
+	// VerifiedAt holds the value of the "verified_at" field.
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DomainQuery when eager-loading is set.
+	Edges           DomainEdges `json:"edges"`
+	project_domains *int
+	selectValues    sql.SelectValues
+}
+
+// DomainEdges holds the relations/edges for other nodes in the graph.
+type DomainEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DomainEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Domain) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case domain.FieldVerified:
+			values[i] = new(sql.NullBool)
+		case domain.FieldID:
+			values[i] = new(sql.NullInt64)
+		case domain.FieldHostname, domain.FieldMethod, domain.FieldToken:
+			values[i] = new(sql.NullString)
+		case domain.FieldVerifiedAt, domain.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case domain.ForeignKeys[0]: // project_domains
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Domain fields.
+func (d *Domain) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case domain.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			d.ID = int(value.Int64)
+		case domain.FieldHostname:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hostname", values[i])
+			} else if value.Valid {
+				d.Hostname = value.String
+			}
+		case domain.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				d.Method = domain.Method(value.String)
+			}
+		case domain.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				d.Token = value.String
+			}
+		case domain.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				d.Verified = value.Bool
+			}
+		case domain.FieldVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_at", values[i])
+			} else if value.Valid {
+				d.VerifiedAt = new(time.Time)
+				*d.VerifiedAt = value.Time
+			}
+		case domain.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				d.CreatedAt = value.Time
+			}
+		case domain.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field project_domains", value)
+			} else if value.Valid {
+				d.project_domains = new(int)
+				*d.project_domains = int(value.Int64)
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Domain.
+// This includes values selected through modifiers, order, etc.
+func (d *Domain) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the Domain entity.
+func (d *Domain) QueryProject() *ProjectQuery {
+	return NewDomainClient(d.config).QueryProject(d)
+}
+
+// Update returns a builder for updating this Domain.
+// Note that you need to call Domain.Unwrap() before calling this method if this Domain
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Domain) Update() *DomainUpdateOne {
+	return NewDomainClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Domain entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Domain) Unwrap() *Domain {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Domain is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Domain) String() string {
+	var builder strings.Builder
+	builder.WriteString("Domain(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("hostname=")
+	builder.WriteString(d.Hostname)
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(fmt.Sprintf("%v", d.Method))
+	builder.WriteString(", ")
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("verified=")
+	builder.WriteString(fmt.Sprintf("%v", d.Verified))
+	builder.WriteString(", ")
+	if v := d.VerifiedAt; v != nil {
+		builder.WriteString("verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Domains is a parsable slice of Domain.
+type Domains []*Domain
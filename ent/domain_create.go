@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/RajBhut/go-basics/ent/domain"
+	"github.com/RajBhut/go-basics/ent/project"
+)
+
+// DomainCreate is the builder for creating a Domain entity.
+type DomainCreate struct {
+	config
+	mutation *DomainMutation
+	hooks    []Hook
+}
+
+// SetHostname sets the "hostname" field.
+func (dc *DomainCreate) SetHostname(s string) *DomainCreate {
+	dc.mutation.SetHostname(s)
+	return dc
+}
+
+// SetMethod sets the "method" field.
+func (dc *DomainCreate) SetMethod(d domain.Method) *DomainCreate {
+	dc.mutation.SetMethod(d)
+	return dc
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (dc *DomainCreate) SetNillableMethod(d *domain.Method) *DomainCreate {
+	if d != nil {
+		dc.SetMethod(*d)
+	}
+	return dc
+}
+
+// SetToken sets the "token" field.
+func (dc *DomainCreate) SetToken(s string) *DomainCreate {
+	dc.mutation.SetToken(s)
+	return dc
+}
+
+// SetVerified sets the "verified" field.
+func (dc *DomainCreate) SetVerified(b bool) *DomainCreate {
+	dc.mutation.SetVerified(b)
+	return dc
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (dc *DomainCreate) SetNillableVerified(b *bool) *DomainCreate {
+	if b != nil {
+		dc.SetVerified(*b)
+	}
+	return dc
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (dc *DomainCreate) SetVerifiedAt(t time.Time) *DomainCreate {
+	dc.mutation.SetVerifiedAt(t)
+	return dc
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (dc *DomainCreate) SetNillableVerifiedAt(t *time.Time) *DomainCreate {
+	if t != nil {
+		dc.SetVerifiedAt(*t)
+	}
+	return dc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dc *DomainCreate) SetCreatedAt(t time.Time) *DomainCreate {
+	dc.mutation.SetCreatedAt(t)
+	return dc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dc *DomainCreate) SetNillableCreatedAt(t *time.Time) *DomainCreate {
+	if t != nil {
+		dc.SetCreatedAt(*t)
+	}
+	return dc
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (dc *DomainCreate) SetProjectID(id int) *DomainCreate {
+	dc.mutation.SetProjectID(id)
+	return dc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (dc *DomainCreate) SetProject(p *Project) *DomainCreate {
+	return dc.SetProjectID(p.ID)
+}
+
+// Mutation returns the DomainMutation object of the builder.
+func (dc *DomainCreate) Mutation() *DomainMutation {
+	return dc.mutation
+}
+
+// Save creates the Domain in the database.
+func (dc *DomainCreate) Save(ctx context.Context) (*Domain, error) {
+	dc.defaults()
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DomainCreate) SaveX(ctx context.Context) *Domain {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DomainCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DomainCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DomainCreate) defaults() {
+	if _, ok := dc.mutation.Method(); !ok {
+		v := domain.DefaultMethod
+		dc.mutation.SetMethod(v)
+	}
+	if _, ok := dc.mutation.Verified(); !ok {
+		v := domain.DefaultVerified
+		dc.mutation.SetVerified(v)
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		v := domain.DefaultCreatedAt()
+		dc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DomainCreate) check() error {
+	if _, ok := dc.mutation.Hostname(); !ok {
+		return &ValidationError{Name: "hostname", err: errors.New(`ent: missing required field "Domain.hostname"`)}
+	}
+	if v, ok := dc.mutation.Hostname(); ok {
+		if err := domain.HostnameValidator(v); err != nil {
+			return &ValidationError{Name: "hostname", err: fmt.Errorf(`ent: validator failed for field "Domain.hostname": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "Domain.method"`)}
+	}
+	if v, ok := dc.mutation.Method(); ok {
+		if err := domain.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "Domain.method": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "Domain.token"`)}
+	}
+	if v, ok := dc.mutation.Token(); ok {
+		if err := domain.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Domain.token": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Verified(); !ok {
+		return &ValidationError{Name: "verified", err: errors.New(`ent: missing required field "Domain.verified"`)}
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Domain.created_at"`)}
+	}
+	if len(dc.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "Domain.project"`)}
+	}
+	return nil
+}
+
+func (dc *DomainCreate) sqlSave(ctx context.Context) (*Domain, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DomainCreate) createSpec() (*Domain, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Domain{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(domain.Table, sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt))
+	)
+	if value, ok := dc.mutation.Hostname(); ok {
+		_spec.SetField(domain.FieldHostname, field.TypeString, value)
+		_node.Hostname = value
+	}
+	if value, ok := dc.mutation.Method(); ok {
+		_spec.SetField(domain.FieldMethod, field.TypeEnum, value)
+		_node.Method = value
+	}
+	if value, ok := dc.mutation.Token(); ok {
+		_spec.SetField(domain.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := dc.mutation.Verified(); ok {
+		_spec.SetField(domain.FieldVerified, field.TypeBool, value)
+		_node.Verified = value
+	}
+	if value, ok := dc.mutation.VerifiedAt(); ok {
+		_spec.SetField(domain.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = &value
+	}
+	if value, ok := dc.mutation.CreatedAt(); ok {
+		_spec.SetField(domain.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := dc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   domain.ProjectTable,
+			Columns: []string{domain.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.project_domains = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DomainCreateBulk is the builder for creating many Domain entities in bulk.
+type DomainCreateBulk struct {
+	config
+	err      error
+	builders []*DomainCreate
+}
+
+// Save creates the Domain entities in the database.
+func (dcb *DomainCreateBulk) Save(ctx context.Context) ([]*Domain, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Domain, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DomainMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DomainCreateBulk) SaveX(ctx context.Context) []*Domain {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DomainCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DomainCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,509 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/RajBhut/go-basics/ent/domain"
+	"github.com/RajBhut/go-basics/ent/predicate"
+	"github.com/RajBhut/go-basics/ent/project"
+)
+
+// DomainUpdate is the builder for updating Domain entities.
+type DomainUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DomainMutation
+}
+
+// Where appends a list predicates to the DomainUpdate builder.
+func (du *DomainUpdate) Where(ps ...predicate.Domain) *DomainUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetHostname sets the "hostname" field.
+func (du *DomainUpdate) SetHostname(s string) *DomainUpdate {
+	du.mutation.SetHostname(s)
+	return du
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (du *DomainUpdate) SetNillableHostname(s *string) *DomainUpdate {
+	if s != nil {
+		du.SetHostname(*s)
+	}
+	return du
+}
+
+// SetMethod sets the "method" field.
+func (du *DomainUpdate) SetMethod(d domain.Method) *DomainUpdate {
+	du.mutation.SetMethod(d)
+	return du
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (du *DomainUpdate) SetNillableMethod(d *domain.Method) *DomainUpdate {
+	if d != nil {
+		du.SetMethod(*d)
+	}
+	return du
+}
+
+// SetToken sets the "token" field.
+func (du *DomainUpdate) SetToken(s string) *DomainUpdate {
+	du.mutation.SetToken(s)
+	return du
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (du *DomainUpdate) SetNillableToken(s *string) *DomainUpdate {
+	if s != nil {
+		du.SetToken(*s)
+	}
+	return du
+}
+
+// SetVerified sets the "verified" field.
+func (du *DomainUpdate) SetVerified(b bool) *DomainUpdate {
+	du.mutation.SetVerified(b)
+	return du
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (du *DomainUpdate) SetNillableVerified(b *bool) *DomainUpdate {
+	if b != nil {
+		du.SetVerified(*b)
+	}
+	return du
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (du *DomainUpdate) SetVerifiedAt(t time.Time) *DomainUpdate {
+	du.mutation.SetVerifiedAt(t)
+	return du
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (du *DomainUpdate) SetNillableVerifiedAt(t *time.Time) *DomainUpdate {
+	if t != nil {
+		du.SetVerifiedAt(*t)
+	}
+	return du
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (du *DomainUpdate) ClearVerifiedAt() *DomainUpdate {
+	du.mutation.ClearVerifiedAt()
+	return du
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (du *DomainUpdate) SetProjectID(id int) *DomainUpdate {
+	du.mutation.SetProjectID(id)
+	return du
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (du *DomainUpdate) SetProject(p *Project) *DomainUpdate {
+	return du.SetProjectID(p.ID)
+}
+
+// Mutation returns the DomainMutation object of the builder.
+func (du *DomainUpdate) Mutation() *DomainMutation {
+	return du.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (du *DomainUpdate) ClearProject() *DomainUpdate {
+	du.mutation.ClearProject()
+	return du
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DomainUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DomainUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DomainUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DomainUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DomainUpdate) check() error {
+	if v, ok := du.mutation.Hostname(); ok {
+		if err := domain.HostnameValidator(v); err != nil {
+			return &ValidationError{Name: "hostname", err: fmt.Errorf(`ent: validator failed for field "Domain.hostname": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.Method(); ok {
+		if err := domain.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "Domain.method": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.Token(); ok {
+		if err := domain.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Domain.token": %w`, err)}
+		}
+	}
+	if du.mutation.ProjectCleared() && len(du.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Domain.project"`)
+	}
+	return nil
+}
+
+func (du *DomainUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := du.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(domain.Table, domain.Columns, sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.Hostname(); ok {
+		_spec.SetField(domain.FieldHostname, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Method(); ok {
+		_spec.SetField(domain.FieldMethod, field.TypeEnum, value)
+	}
+	if value, ok := du.mutation.Token(); ok {
+		_spec.SetField(domain.FieldToken, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Verified(); ok {
+		_spec.SetField(domain.FieldVerified, field.TypeBool, value)
+	}
+	if value, ok := du.mutation.VerifiedAt(); ok {
+		_spec.SetField(domain.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if du.mutation.VerifiedAtCleared() {
+		_spec.ClearField(domain.FieldVerifiedAt, field.TypeTime)
+	}
+	if du.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   domain.ProjectTable,
+			Columns: []string{domain.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   domain.ProjectTable,
+			Columns: []string{domain.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{domain.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DomainUpdateOne is the builder for updating a single Domain entity.
+type DomainUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DomainMutation
+}
+
+// SetHostname sets the "hostname" field.
+func (duo *DomainUpdateOne) SetHostname(s string) *DomainUpdateOne {
+	duo.mutation.SetHostname(s)
+	return duo
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (duo *DomainUpdateOne) SetNillableHostname(s *string) *DomainUpdateOne {
+	if s != nil {
+		duo.SetHostname(*s)
+	}
+	return duo
+}
+
+// SetMethod sets the "method" field.
+func (duo *DomainUpdateOne) SetMethod(d domain.Method) *DomainUpdateOne {
+	duo.mutation.SetMethod(d)
+	return duo
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (duo *DomainUpdateOne) SetNillableMethod(d *domain.Method) *DomainUpdateOne {
+	if d != nil {
+		duo.SetMethod(*d)
+	}
+	return duo
+}
+
+// SetToken sets the "token" field.
+func (duo *DomainUpdateOne) SetToken(s string) *DomainUpdateOne {
+	duo.mutation.SetToken(s)
+	return duo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (duo *DomainUpdateOne) SetNillableToken(s *string) *DomainUpdateOne {
+	if s != nil {
+		duo.SetToken(*s)
+	}
+	return duo
+}
+
+// SetVerified sets the "verified" field.
+func (duo *DomainUpdateOne) SetVerified(b bool) *DomainUpdateOne {
+	duo.mutation.SetVerified(b)
+	return duo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (duo *DomainUpdateOne) SetNillableVerified(b *bool) *DomainUpdateOne {
+	if b != nil {
+		duo.SetVerified(*b)
+	}
+	return duo
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (duo *DomainUpdateOne) SetVerifiedAt(t time.Time) *DomainUpdateOne {
+	duo.mutation.SetVerifiedAt(t)
+	return duo
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (duo *DomainUpdateOne) SetNillableVerifiedAt(t *time.Time) *DomainUpdateOne {
+	if t != nil {
+		duo.SetVerifiedAt(*t)
+	}
+	return duo
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (duo *DomainUpdateOne) ClearVerifiedAt() *DomainUpdateOne {
+	duo.mutation.ClearVerifiedAt()
+	return duo
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (duo *DomainUpdateOne) SetProjectID(id int) *DomainUpdateOne {
+	duo.mutation.SetProjectID(id)
+	return duo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (duo *DomainUpdateOne) SetProject(p *Project) *DomainUpdateOne {
+	return duo.SetProjectID(p.ID)
+}
+
+// Mutation returns the DomainMutation object of the builder.
+func (duo *DomainUpdateOne) Mutation() *DomainMutation {
+	return duo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (duo *DomainUpdateOne) ClearProject() *DomainUpdateOne {
+	duo.mutation.ClearProject()
+	return duo
+}
+
+// Where appends a list predicates to the DomainUpdate builder.
+func (duo *DomainUpdateOne) Where(ps ...predicate.Domain) *DomainUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DomainUpdateOne) Select(field string, fields ...string) *DomainUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Domain entity.
+func (duo *DomainUpdateOne) Save(ctx context.Context) (*Domain, error) {
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DomainUpdateOne) SaveX(ctx context.Context) *Domain {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DomainUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DomainUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DomainUpdateOne) check() error {
+	if v, ok := duo.mutation.Hostname(); ok {
+		if err := domain.HostnameValidator(v); err != nil {
+			return &ValidationError{Name: "hostname", err: fmt.Errorf(`ent: validator failed for field "Domain.hostname": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.Method(); ok {
+		if err := domain.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "Domain.method": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.Token(); ok {
+		if err := domain.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Domain.token": %w`, err)}
+		}
+	}
+	if duo.mutation.ProjectCleared() && len(duo.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Domain.project"`)
+	}
+	return nil
+}
+
+func (duo *DomainUpdateOne) sqlSave(ctx context.Context) (_node *Domain, err error) {
+	if err := duo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(domain.Table, domain.Columns, sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Domain.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, domain.FieldID)
+		for _, f := range fields {
+			if !domain.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != domain.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.Hostname(); ok {
+		_spec.SetField(domain.FieldHostname, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Method(); ok {
+		_spec.SetField(domain.FieldMethod, field.TypeEnum, value)
+	}
+	if value, ok := duo.mutation.Token(); ok {
+		_spec.SetField(domain.FieldToken, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Verified(); ok {
+		_spec.SetField(domain.FieldVerified, field.TypeBool, value)
+	}
+	if value, ok := duo.mutation.VerifiedAt(); ok {
+		_spec.SetField(domain.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if duo.mutation.VerifiedAtCleared() {
+		_spec.ClearField(domain.FieldVerifiedAt, field.TypeTime)
+	}
+	if duo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   domain.ProjectTable,
+			Columns: []string{domain.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   domain.ProjectTable,
+			Columns: []string{domain.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Domain{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{domain.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
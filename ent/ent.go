@@ -12,6 +12,10 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/RajBhut/go-basics/ent/deployment"
+	"github.com/RajBhut/go-basics/ent/domain"
+	"github.com/RajBhut/go-basics/ent/envvar"
+	"github.com/RajBhut/go-basics/ent/project"
 	"github.com/RajBhut/go-basics/ent/task"
 	"github.com/RajBhut/go-basics/ent/user"
 )
@@ -74,8 +78,12 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			task.Table: task.ValidColumn,
-			user.Table: user.ValidColumn,
+			deployment.Table: deployment.ValidColumn,
+			domain.Table:     domain.ValidColumn,
+			envvar.Table:     envvar.ValidColumn,
+			project.Table:    project.ValidColumn,
+			task.Table:       task.ValidColumn,
+			user.Table:       user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
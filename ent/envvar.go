@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/RajBhut/go-basics/ent/envvar"
+	"github.com/RajBhut/go-basics/ent/project"
+)
+
+// EnvVar is the model entity for the EnvVar schema.
+type EnvVar struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Value holds the value of the "value" field.
+	Value []byte `json:"-"`
+	// Environment holds the value of the "environment" field.
+	Environment envvar.Environment `json:"environment,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EnvVarQuery when eager-loading is set.
+	Edges            EnvVarEdges `json:"edges"`
+	project_env_vars *int
+	selectValues     sql.SelectValues
+}
+
+// EnvVarEdges holds the relations/edges for other nodes in the graph.
+type EnvVarEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnvVarEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EnvVar) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case envvar.FieldValue:
+			values[i] = new([]byte)
+		case envvar.FieldID:
+			values[i] = new(sql.NullInt64)
+		case envvar.FieldKey, envvar.FieldEnvironment:
+			values[i] = new(sql.NullString)
+		case envvar.FieldCreatedAt, envvar.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case envvar.ForeignKeys[0]: // project_env_vars
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EnvVar fields.
+func (ev *EnvVar) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case envvar.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ev.ID = int(value.Int64)
+		case envvar.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				ev.Key = value.String
+			}
+		case envvar.FieldValue:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value != nil {
+				ev.Value = *value
+			}
+		case envvar.FieldEnvironment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment", values[i])
+			} else if value.Valid {
+				ev.Environment = envvar.Environment(value.String)
+			}
+		case envvar.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ev.CreatedAt = value.Time
+			}
+		case envvar.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ev.UpdatedAt = value.Time
+			}
+		case envvar.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field project_env_vars", value)
+			} else if value.Valid {
+				ev.project_env_vars = new(int)
+				*ev.project_env_vars = int(value.Int64)
+			}
+		default:
+			ev.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the EnvVar.
+// This includes values selected through modifiers, order, etc.
+func (ev *EnvVar) GetValue(name string) (ent.Value, error) {
+	return ev.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the EnvVar entity.
+func (ev *EnvVar) QueryProject() *ProjectQuery {
+	return NewEnvVarClient(ev.config).QueryProject(ev)
+}
+
+// Update returns a builder for updating this EnvVar.
+// Note that you need to call EnvVar.Unwrap() before calling this method if this EnvVar
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ev *EnvVar) Update() *EnvVarUpdateOne {
+	return NewEnvVarClient(ev.config).UpdateOne(ev)
+}
+
+// Unwrap unwraps the EnvVar entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ev *EnvVar) Unwrap() *EnvVar {
+	_tx, ok := ev.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EnvVar is not a transactional entity")
+	}
+	ev.config.driver = _tx.drv
+	return ev
+}
+
+// String implements the fmt.Stringer.
+func (ev *EnvVar) String() string {
+	var builder strings.Builder
+	builder.WriteString("EnvVar(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ev.ID))
+	builder.WriteString("key=")
+	builder.WriteString(ev.Key)
+	builder.WriteString(", ")
+	builder.WriteString("value=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("environment=")
+	builder.WriteString(fmt.Sprintf("%v", ev.Environment))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ev.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ev.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EnvVars is a parsable slice of EnvVar.
+type EnvVars []*EnvVar
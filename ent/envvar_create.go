@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/RajBhut/go-basics/ent/envvar"
+	"github.com/RajBhut/go-basics/ent/project"
+)
+
+// EnvVarCreate is the builder for creating a EnvVar entity.
+type EnvVarCreate struct {
+	config
+	mutation *EnvVarMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (evc *EnvVarCreate) SetKey(s string) *EnvVarCreate {
+	evc.mutation.SetKey(s)
+	return evc
+}
+
+// SetValue sets the "value" field.
+func (evc *EnvVarCreate) SetValue(b []byte) *EnvVarCreate {
+	evc.mutation.SetValue(b)
+	return evc
+}
+
+// SetEnvironment sets the "environment" field.
+func (evc *EnvVarCreate) SetEnvironment(e envvar.Environment) *EnvVarCreate {
+	evc.mutation.SetEnvironment(e)
+	return evc
+}
+
+// SetNillableEnvironment sets the "environment" field if the given value is not nil.
+func (evc *EnvVarCreate) SetNillableEnvironment(e *envvar.Environment) *EnvVarCreate {
+	if e != nil {
+		evc.SetEnvironment(*e)
+	}
+	return evc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (evc *EnvVarCreate) SetCreatedAt(t time.Time) *EnvVarCreate {
+	evc.mutation.SetCreatedAt(t)
+	return evc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (evc *EnvVarCreate) SetNillableCreatedAt(t *time.Time) *EnvVarCreate {
+	if t != nil {
+		evc.SetCreatedAt(*t)
+	}
+	return evc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (evc *EnvVarCreate) SetUpdatedAt(t time.Time) *EnvVarCreate {
+	evc.mutation.SetUpdatedAt(t)
+	return evc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (evc *EnvVarCreate) SetNillableUpdatedAt(t *time.Time) *EnvVarCreate {
+	if t != nil {
+		evc.SetUpdatedAt(*t)
+	}
+	return evc
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (evc *EnvVarCreate) SetProjectID(id int) *EnvVarCreate {
+	evc.mutation.SetProjectID(id)
+	return evc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (evc *EnvVarCreate) SetProject(p *Project) *EnvVarCreate {
+	return evc.SetProjectID(p.ID)
+}
+
+// Mutation returns the EnvVarMutation object of the builder.
+func (evc *EnvVarCreate) Mutation() *EnvVarMutation {
+	return evc.mutation
+}
+
+// Save creates the EnvVar in the database.
+func (evc *EnvVarCreate) Save(ctx context.Context) (*EnvVar, error) {
+	evc.defaults()
+	return withHooks(ctx, evc.sqlSave, evc.mutation, evc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (evc *EnvVarCreate) SaveX(ctx context.Context) *EnvVar {
+	v, err := evc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (evc *EnvVarCreate) Exec(ctx context.Context) error {
+	_, err := evc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evc *EnvVarCreate) ExecX(ctx context.Context) {
+	if err := evc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (evc *EnvVarCreate) defaults() {
+	if _, ok := evc.mutation.Environment(); !ok {
+		v := envvar.DefaultEnvironment
+		evc.mutation.SetEnvironment(v)
+	}
+	if _, ok := evc.mutation.CreatedAt(); !ok {
+		v := envvar.DefaultCreatedAt()
+		evc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := evc.mutation.UpdatedAt(); !ok {
+		v := envvar.DefaultUpdatedAt()
+		evc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (evc *EnvVarCreate) check() error {
+	if _, ok := evc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "EnvVar.key"`)}
+	}
+	if v, ok := evc.mutation.Key(); ok {
+		if err := envvar.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "EnvVar.key": %w`, err)}
+		}
+	}
+	if _, ok := evc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "EnvVar.value"`)}
+	}
+	if _, ok := evc.mutation.Environment(); !ok {
+		return &ValidationError{Name: "environment", err: errors.New(`ent: missing required field "EnvVar.environment"`)}
+	}
+	if v, ok := evc.mutation.Environment(); ok {
+		if err := envvar.EnvironmentValidator(v); err != nil {
+			return &ValidationError{Name: "environment", err: fmt.Errorf(`ent: validator failed for field "EnvVar.environment": %w`, err)}
+		}
+	}
+	if _, ok := evc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EnvVar.created_at"`)}
+	}
+	if _, ok := evc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EnvVar.updated_at"`)}
+	}
+	if len(evc.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "EnvVar.project"`)}
+	}
+	return nil
+}
+
+func (evc *EnvVarCreate) sqlSave(ctx context.Context) (*EnvVar, error) {
+	if err := evc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := evc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, evc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	evc.mutation.id = &_node.ID
+	evc.mutation.done = true
+	return _node, nil
+}
+
+func (evc *EnvVarCreate) createSpec() (*EnvVar, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnvVar{config: evc.config}
+		_spec = sqlgraph.NewCreateSpec(envvar.Table, sqlgraph.NewFieldSpec(envvar.FieldID, field.TypeInt))
+	)
+	if value, ok := evc.mutation.Key(); ok {
+		_spec.SetField(envvar.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := evc.mutation.Value(); ok {
+		_spec.SetField(envvar.FieldValue, field.TypeBytes, value)
+		_node.Value = value
+	}
+	if value, ok := evc.mutation.Environment(); ok {
+		_spec.SetField(envvar.FieldEnvironment, field.TypeEnum, value)
+		_node.Environment = value
+	}
+	if value, ok := evc.mutation.CreatedAt(); ok {
+		_spec.SetField(envvar.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := evc.mutation.UpdatedAt(); ok {
+		_spec.SetField(envvar.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := evc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envvar.ProjectTable,
+			Columns: []string{envvar.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.project_env_vars = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EnvVarCreateBulk is the builder for creating many EnvVar entities in bulk.
+type EnvVarCreateBulk struct {
+	config
+	err      error
+	builders []*EnvVarCreate
+}
+
+// Save creates the EnvVar entities in the database.
+func (evcb *EnvVarCreateBulk) Save(ctx context.Context) ([]*EnvVar, error) {
+	if evcb.err != nil {
+		return nil, evcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(evcb.builders))
+	nodes := make([]*EnvVar, len(evcb.builders))
+	mutators := make([]Mutator, len(evcb.builders))
+	for i := range evcb.builders {
+		func(i int, root context.Context) {
+			builder := evcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnvVarMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, evcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, evcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, evcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evcb *EnvVarCreateBulk) SaveX(ctx context.Context) []*EnvVar {
+	v, err := evcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (evcb *EnvVarCreateBulk) Exec(ctx context.Context) error {
+	_, err := evcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evcb *EnvVarCreateBulk) ExecX(ctx context.Context) {
+	if err := evcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
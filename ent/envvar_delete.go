@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/RajBhut/go-basics/ent/envvar"
+	"github.com/RajBhut/go-basics/ent/predicate"
+)
+
+// EnvVarDelete is the builder for deleting a EnvVar entity.
+type EnvVarDelete struct {
+	config
+	hooks    []Hook
+	mutation *EnvVarMutation
+}
+
+// Where appends a list predicates to the EnvVarDelete builder.
+func (evd *EnvVarDelete) Where(ps ...predicate.EnvVar) *EnvVarDelete {
+	evd.mutation.Where(ps...)
+	return evd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (evd *EnvVarDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, evd.sqlExec, evd.mutation, evd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evd *EnvVarDelete) ExecX(ctx context.Context) int {
+	n, err := evd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (evd *EnvVarDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(envvar.Table, sqlgraph.NewFieldSpec(envvar.FieldID, field.TypeInt))
+	if ps := evd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, evd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	evd.mutation.done = true
+	return affected, err
+}
+
+// EnvVarDeleteOne is the builder for deleting a single EnvVar entity.
+type EnvVarDeleteOne struct {
+	evd *EnvVarDelete
+}
+
+// Where appends a list predicates to the EnvVarDelete builder.
+func (evdo *EnvVarDeleteOne) Where(ps ...predicate.EnvVar) *EnvVarDeleteOne {
+	evdo.evd.mutation.Where(ps...)
+	return evdo
+}
+
+// Exec executes the deletion query.
+func (evdo *EnvVarDeleteOne) Exec(ctx context.Context) error {
+	n, err := evdo.evd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{envvar.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evdo *EnvVarDeleteOne) ExecX(ctx context.Context) {
+	if err := evdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
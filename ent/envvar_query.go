@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/RajBhut/go-basics/ent/envvar"
+	"github.com/RajBhut/go-basics/ent/predicate"
+	"github.com/RajBhut/go-basics/ent/project"
+)
+
+// EnvVarQuery is the builder for querying EnvVar entities.
+type EnvVarQuery struct {
+	config
+	ctx         *QueryContext
+	order       []envvar.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.EnvVar
+	withProject *ProjectQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EnvVarQuery builder.
+func (evq *EnvVarQuery) Where(ps ...predicate.EnvVar) *EnvVarQuery {
+	evq.predicates = append(evq.predicates, ps...)
+	return evq
+}
+
+// Limit the number of records to be returned by this query.
+func (evq *EnvVarQuery) Limit(limit int) *EnvVarQuery {
+	evq.ctx.Limit = &limit
+	return evq
+}
+
+// Offset to start from.
+func (evq *EnvVarQuery) Offset(offset int) *EnvVarQuery {
+	evq.ctx.Offset = &offset
+	return evq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (evq *EnvVarQuery) Unique(unique bool) *EnvVarQuery {
+	evq.ctx.Unique = &unique
+	return evq
+}
+
+// Order specifies how the records should be ordered.
+func (evq *EnvVarQuery) Order(o ...envvar.OrderOption) *EnvVarQuery {
+	evq.order = append(evq.order, o...)
+	return evq
+}
+
+// QueryProject chains the current query on the "project" edge.
+func (evq *EnvVarQuery) QueryProject() *ProjectQuery {
+	query := (&ProjectClient{config: evq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := evq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := evq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(envvar.Table, envvar.FieldID, selector),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, envvar.ProjectTable, envvar.ProjectColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(evq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EnvVar entity from the query.
+// Returns a *NotFoundError when no EnvVar was found.
+func (evq *EnvVarQuery) First(ctx context.Context) (*EnvVar, error) {
+	nodes, err := evq.Limit(1).All(setContextOp(ctx, evq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{envvar.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (evq *EnvVarQuery) FirstX(ctx context.Context) *EnvVar {
+	node, err := evq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EnvVar ID from the query.
+// Returns a *NotFoundError when no EnvVar ID was found.
+func (evq *EnvVarQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = evq.Limit(1).IDs(setContextOp(ctx, evq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{envvar.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (evq *EnvVarQuery) FirstIDX(ctx context.Context) int {
+	id, err := evq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EnvVar entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EnvVar entity is found.
+// Returns a *NotFoundError when no EnvVar entities are found.
+func (evq *EnvVarQuery) Only(ctx context.Context) (*EnvVar, error) {
+	nodes, err := evq.Limit(2).All(setContextOp(ctx, evq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{envvar.Label}
+	default:
+		return nil, &NotSingularError{envvar.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (evq *EnvVarQuery) OnlyX(ctx context.Context) *EnvVar {
+	node, err := evq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EnvVar ID in the query.
+// Returns a *NotSingularError when more than one EnvVar ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (evq *EnvVarQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = evq.Limit(2).IDs(setContextOp(ctx, evq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{envvar.Label}
+	default:
+		err = &NotSingularError{envvar.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (evq *EnvVarQuery) OnlyIDX(ctx context.Context) int {
+	id, err := evq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EnvVars.
+func (evq *EnvVarQuery) All(ctx context.Context) ([]*EnvVar, error) {
+	ctx = setContextOp(ctx, evq.ctx, ent.OpQueryAll)
+	if err := evq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EnvVar, *EnvVarQuery]()
+	return withInterceptors[[]*EnvVar](ctx, evq, qr, evq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (evq *EnvVarQuery) AllX(ctx context.Context) []*EnvVar {
+	nodes, err := evq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EnvVar IDs.
+func (evq *EnvVarQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if evq.ctx.Unique == nil && evq.path != nil {
+		evq.Unique(true)
+	}
+	ctx = setContextOp(ctx, evq.ctx, ent.OpQueryIDs)
+	if err = evq.Select(envvar.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (evq *EnvVarQuery) IDsX(ctx context.Context) []int {
+	ids, err := evq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (evq *EnvVarQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, evq.ctx, ent.OpQueryCount)
+	if err := evq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, evq, querierCount[*EnvVarQuery](), evq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (evq *EnvVarQuery) CountX(ctx context.Context) int {
+	count, err := evq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (evq *EnvVarQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, evq.ctx, ent.OpQueryExist)
+	switch _, err := evq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (evq *EnvVarQuery) ExistX(ctx context.Context) bool {
+	exist, err := evq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EnvVarQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (evq *EnvVarQuery) Clone() *EnvVarQuery {
+	if evq == nil {
+		return nil
+	}
+	return &EnvVarQuery{
+		config:      evq.config,
+		ctx:         evq.ctx.Clone(),
+		order:       append([]envvar.OrderOption{}, evq.order...),
+		inters:      append([]Interceptor{}, evq.inters...),
+		predicates:  append([]predicate.EnvVar{}, evq.predicates...),
+		withProject: evq.withProject.Clone(),
+		// clone intermediate query.
+		sql:  evq.sql.Clone(),
+		path: evq.path,
+	}
+}
+
+// WithProject tells the query-builder to eager-load the nodes that are connected to
+// the "project" edge. The optional arguments are used to configure the query builder of the edge.
+func (evq *EnvVarQuery) WithProject(opts ...func(*ProjectQuery)) *EnvVarQuery {
+	query := (&ProjectClient{config: evq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	evq.withProject = query
+	return evq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Key string `json:"key,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EnvVar.Query().
+//		GroupBy(envvar.FieldKey).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (evq *EnvVarQuery) GroupBy(field string, fields ...string) *EnvVarGroupBy {
+	evq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EnvVarGroupBy{build: evq}
+	grbuild.flds = &evq.ctx.Fields
+	grbuild.label = envvar.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Key string `json:"key,omitempty"`
+//	}
+//
+//	client.EnvVar.Query().
+//		Select(envvar.FieldKey).
+//		Scan(ctx, &v)
+func (evq *EnvVarQuery) Select(fields ...string) *EnvVarSelect {
+	evq.ctx.Fields = append(evq.ctx.Fields, fields...)
+	sbuild := &EnvVarSelect{EnvVarQuery: evq}
+	sbuild.label = envvar.Label
+	sbuild.flds, sbuild.scan = &evq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EnvVarSelect configured with the given aggregations.
+func (evq *EnvVarQuery) Aggregate(fns ...AggregateFunc) *EnvVarSelect {
+	return evq.Select().Aggregate(fns...)
+}
+
+func (evq *EnvVarQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range evq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, evq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range evq.ctx.Fields {
+		if !envvar.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if evq.path != nil {
+		prev, err := evq.path(ctx)
+		if err != nil {
+			return err
+		}
+		evq.sql = prev
+	}
+	return nil
+}
+
+func (evq *EnvVarQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EnvVar, error) {
+	var (
+		nodes       = []*EnvVar{}
+		withFKs     = evq.withFKs
+		_spec       = evq.querySpec()
+		loadedTypes = [1]bool{
+			evq.withProject != nil,
+		}
+	)
+	if evq.withProject != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, envvar.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EnvVar).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EnvVar{config: evq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, evq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := evq.withProject; query != nil {
+		if err := evq.loadProject(ctx, query, nodes, nil,
+			func(n *EnvVar, e *Project) { n.Edges.Project = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (evq *EnvVarQuery) loadProject(ctx context.Context, query *ProjectQuery, nodes []*EnvVar, init func(*EnvVar), assign func(*EnvVar, *Project)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*EnvVar)
+	for i := range nodes {
+		if nodes[i].project_env_vars == nil {
+			continue
+		}
+		fk := *nodes[i].project_env_vars
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(project.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "project_env_vars" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (evq *EnvVarQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := evq.querySpec()
+	_spec.Node.Columns = evq.ctx.Fields
+	if len(evq.ctx.Fields) > 0 {
+		_spec.Unique = evq.ctx.Unique != nil && *evq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, evq.driver, _spec)
+}
+
+func (evq *EnvVarQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(envvar.Table, envvar.Columns, sqlgraph.NewFieldSpec(envvar.FieldID, field.TypeInt))
+	_spec.From = evq.sql
+	if unique := evq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if evq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := evq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, envvar.FieldID)
+		for i := range fields {
+			if fields[i] != envvar.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := evq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := evq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := evq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := evq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (evq *EnvVarQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(evq.driver.Dialect())
+	t1 := builder.Table(envvar.Table)
+	columns := evq.ctx.Fields
+	if len(columns) == 0 {
+		columns = envvar.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if evq.sql != nil {
+		selector = evq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if evq.ctx.Unique != nil && *evq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range evq.predicates {
+		p(selector)
+	}
+	for _, p := range evq.order {
+		p(selector)
+	}
+	if offset := evq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := evq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EnvVarGroupBy is the group-by builder for EnvVar entities.
+type EnvVarGroupBy struct {
+	selector
+	build *EnvVarQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (evgb *EnvVarGroupBy) Aggregate(fns ...AggregateFunc) *EnvVarGroupBy {
+	evgb.fns = append(evgb.fns, fns...)
+	return evgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (evgb *EnvVarGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, evgb.build.ctx, ent.OpQueryGroupBy)
+	if err := evgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EnvVarQuery, *EnvVarGroupBy](ctx, evgb.build, evgb, evgb.build.inters, v)
+}
+
+func (evgb *EnvVarGroupBy) sqlScan(ctx context.Context, root *EnvVarQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(evgb.fns))
+	for _, fn := range evgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*evgb.flds)+len(evgb.fns))
+		for _, f := range *evgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*evgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := evgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EnvVarSelect is the builder for selecting fields of EnvVar entities.
+type EnvVarSelect struct {
+	*EnvVarQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (evs *EnvVarSelect) Aggregate(fns ...AggregateFunc) *EnvVarSelect {
+	evs.fns = append(evs.fns, fns...)
+	return evs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (evs *EnvVarSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, evs.ctx, ent.OpQuerySelect)
+	if err := evs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EnvVarQuery, *EnvVarSelect](ctx, evs.EnvVarQuery, evs, evs.inters, v)
+}
+
+func (evs *EnvVarSelect) sqlScan(ctx context.Context, root *EnvVarQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(evs.fns))
+	for _, fn := range evs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*evs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := evs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
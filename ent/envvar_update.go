@@ -0,0 +1,433 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/RajBhut/go-basics/ent/envvar"
+	"github.com/RajBhut/go-basics/ent/predicate"
+	"github.com/RajBhut/go-basics/ent/project"
+)
+
+// EnvVarUpdate is the builder for updating EnvVar entities.
+type EnvVarUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EnvVarMutation
+}
+
+// Where appends a list predicates to the EnvVarUpdate builder.
+func (evu *EnvVarUpdate) Where(ps ...predicate.EnvVar) *EnvVarUpdate {
+	evu.mutation.Where(ps...)
+	return evu
+}
+
+// SetKey sets the "key" field.
+func (evu *EnvVarUpdate) SetKey(s string) *EnvVarUpdate {
+	evu.mutation.SetKey(s)
+	return evu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (evu *EnvVarUpdate) SetNillableKey(s *string) *EnvVarUpdate {
+	if s != nil {
+		evu.SetKey(*s)
+	}
+	return evu
+}
+
+// SetValue sets the "value" field.
+func (evu *EnvVarUpdate) SetValue(b []byte) *EnvVarUpdate {
+	evu.mutation.SetValue(b)
+	return evu
+}
+
+// SetEnvironment sets the "environment" field.
+func (evu *EnvVarUpdate) SetEnvironment(e envvar.Environment) *EnvVarUpdate {
+	evu.mutation.SetEnvironment(e)
+	return evu
+}
+
+// SetNillableEnvironment sets the "environment" field if the given value is not nil.
+func (evu *EnvVarUpdate) SetNillableEnvironment(e *envvar.Environment) *EnvVarUpdate {
+	if e != nil {
+		evu.SetEnvironment(*e)
+	}
+	return evu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (evu *EnvVarUpdate) SetUpdatedAt(t time.Time) *EnvVarUpdate {
+	evu.mutation.SetUpdatedAt(t)
+	return evu
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (evu *EnvVarUpdate) SetProjectID(id int) *EnvVarUpdate {
+	evu.mutation.SetProjectID(id)
+	return evu
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (evu *EnvVarUpdate) SetProject(p *Project) *EnvVarUpdate {
+	return evu.SetProjectID(p.ID)
+}
+
+// Mutation returns the EnvVarMutation object of the builder.
+func (evu *EnvVarUpdate) Mutation() *EnvVarMutation {
+	return evu.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (evu *EnvVarUpdate) ClearProject() *EnvVarUpdate {
+	evu.mutation.ClearProject()
+	return evu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (evu *EnvVarUpdate) Save(ctx context.Context) (int, error) {
+	evu.defaults()
+	return withHooks(ctx, evu.sqlSave, evu.mutation, evu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evu *EnvVarUpdate) SaveX(ctx context.Context) int {
+	affected, err := evu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (evu *EnvVarUpdate) Exec(ctx context.Context) error {
+	_, err := evu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evu *EnvVarUpdate) ExecX(ctx context.Context) {
+	if err := evu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (evu *EnvVarUpdate) defaults() {
+	if _, ok := evu.mutation.UpdatedAt(); !ok {
+		v := envvar.UpdateDefaultUpdatedAt()
+		evu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (evu *EnvVarUpdate) check() error {
+	if v, ok := evu.mutation.Key(); ok {
+		if err := envvar.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "EnvVar.key": %w`, err)}
+		}
+	}
+	if v, ok := evu.mutation.Environment(); ok {
+		if err := envvar.EnvironmentValidator(v); err != nil {
+			return &ValidationError{Name: "environment", err: fmt.Errorf(`ent: validator failed for field "EnvVar.environment": %w`, err)}
+		}
+	}
+	if evu.mutation.ProjectCleared() && len(evu.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnvVar.project"`)
+	}
+	return nil
+}
+
+func (evu *EnvVarUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := evu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(envvar.Table, envvar.Columns, sqlgraph.NewFieldSpec(envvar.FieldID, field.TypeInt))
+	if ps := evu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := evu.mutation.Key(); ok {
+		_spec.SetField(envvar.FieldKey, field.TypeString, value)
+	}
+	if value, ok := evu.mutation.Value(); ok {
+		_spec.SetField(envvar.FieldValue, field.TypeBytes, value)
+	}
+	if value, ok := evu.mutation.Environment(); ok {
+		_spec.SetField(envvar.FieldEnvironment, field.TypeEnum, value)
+	}
+	if value, ok := evu.mutation.UpdatedAt(); ok {
+		_spec.SetField(envvar.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if evu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envvar.ProjectTable,
+			Columns: []string{envvar.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := evu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envvar.ProjectTable,
+			Columns: []string{envvar.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, evu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envvar.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	evu.mutation.done = true
+	return n, nil
+}
+
+// EnvVarUpdateOne is the builder for updating a single EnvVar entity.
+type EnvVarUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EnvVarMutation
+}
+
+// SetKey sets the "key" field.
+func (evuo *EnvVarUpdateOne) SetKey(s string) *EnvVarUpdateOne {
+	evuo.mutation.SetKey(s)
+	return evuo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (evuo *EnvVarUpdateOne) SetNillableKey(s *string) *EnvVarUpdateOne {
+	if s != nil {
+		evuo.SetKey(*s)
+	}
+	return evuo
+}
+
+// SetValue sets the "value" field.
+func (evuo *EnvVarUpdateOne) SetValue(b []byte) *EnvVarUpdateOne {
+	evuo.mutation.SetValue(b)
+	return evuo
+}
+
+// SetEnvironment sets the "environment" field.
+func (evuo *EnvVarUpdateOne) SetEnvironment(e envvar.Environment) *EnvVarUpdateOne {
+	evuo.mutation.SetEnvironment(e)
+	return evuo
+}
+
+// SetNillableEnvironment sets the "environment" field if the given value is not nil.
+func (evuo *EnvVarUpdateOne) SetNillableEnvironment(e *envvar.Environment) *EnvVarUpdateOne {
+	if e != nil {
+		evuo.SetEnvironment(*e)
+	}
+	return evuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (evuo *EnvVarUpdateOne) SetUpdatedAt(t time.Time) *EnvVarUpdateOne {
+	evuo.mutation.SetUpdatedAt(t)
+	return evuo
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (evuo *EnvVarUpdateOne) SetProjectID(id int) *EnvVarUpdateOne {
+	evuo.mutation.SetProjectID(id)
+	return evuo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (evuo *EnvVarUpdateOne) SetProject(p *Project) *EnvVarUpdateOne {
+	return evuo.SetProjectID(p.ID)
+}
+
+// Mutation returns the EnvVarMutation object of the builder.
+func (evuo *EnvVarUpdateOne) Mutation() *EnvVarMutation {
+	return evuo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (evuo *EnvVarUpdateOne) ClearProject() *EnvVarUpdateOne {
+	evuo.mutation.ClearProject()
+	return evuo
+}
+
+// Where appends a list predicates to the EnvVarUpdate builder.
+func (evuo *EnvVarUpdateOne) Where(ps ...predicate.EnvVar) *EnvVarUpdateOne {
+	evuo.mutation.Where(ps...)
+	return evuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (evuo *EnvVarUpdateOne) Select(field string, fields ...string) *EnvVarUpdateOne {
+	evuo.fields = append([]string{field}, fields...)
+	return evuo
+}
+
+// Save executes the query and returns the updated EnvVar entity.
+func (evuo *EnvVarUpdateOne) Save(ctx context.Context) (*EnvVar, error) {
+	evuo.defaults()
+	return withHooks(ctx, evuo.sqlSave, evuo.mutation, evuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evuo *EnvVarUpdateOne) SaveX(ctx context.Context) *EnvVar {
+	node, err := evuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (evuo *EnvVarUpdateOne) Exec(ctx context.Context) error {
+	_, err := evuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evuo *EnvVarUpdateOne) ExecX(ctx context.Context) {
+	if err := evuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (evuo *EnvVarUpdateOne) defaults() {
+	if _, ok := evuo.mutation.UpdatedAt(); !ok {
+		v := envvar.UpdateDefaultUpdatedAt()
+		evuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (evuo *EnvVarUpdateOne) check() error {
+	if v, ok := evuo.mutation.Key(); ok {
+		if err := envvar.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "EnvVar.key": %w`, err)}
+		}
+	}
+	if v, ok := evuo.mutation.Environment(); ok {
+		if err := envvar.EnvironmentValidator(v); err != nil {
+			return &ValidationError{Name: "environment", err: fmt.Errorf(`ent: validator failed for field "EnvVar.environment": %w`, err)}
+		}
+	}
+	if evuo.mutation.ProjectCleared() && len(evuo.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnvVar.project"`)
+	}
+	return nil
+}
+
+func (evuo *EnvVarUpdateOne) sqlSave(ctx context.Context) (_node *EnvVar, err error) {
+	if err := evuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(envvar.Table, envvar.Columns, sqlgraph.NewFieldSpec(envvar.FieldID, field.TypeInt))
+	id, ok := evuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EnvVar.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := evuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, envvar.FieldID)
+		for _, f := range fields {
+			if !envvar.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != envvar.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := evuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := evuo.mutation.Key(); ok {
+		_spec.SetField(envvar.FieldKey, field.TypeString, value)
+	}
+	if value, ok := evuo.mutation.Value(); ok {
+		_spec.SetField(envvar.FieldValue, field.TypeBytes, value)
+	}
+	if value, ok := evuo.mutation.Environment(); ok {
+		_spec.SetField(envvar.FieldEnvironment, field.TypeEnum, value)
+	}
+	if value, ok := evuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(envvar.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if evuo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envvar.ProjectTable,
+			Columns: []string{envvar.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := evuo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envvar.ProjectTable,
+			Columns: []string{envvar.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EnvVar{config: evuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, evuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envvar.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	evuo.mutation.done = true
+	return _node, nil
+}
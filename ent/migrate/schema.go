@@ -3,11 +3,154 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// DeploymentsColumns holds the columns for the "deployments" table.
+	DeploymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "owner", Type: field.TypeString},
+		{Name: "repo", Type: field.TypeString},
+		{Name: "ref", Type: field.TypeString, Nullable: true},
+		{Name: "environment", Type: field.TypeString, Default: "production"},
+		{Name: "pr_number", Type: field.TypeInt, Nullable: true},
+		{Name: "commit_sha", Type: field.TypeString, Nullable: true},
+		{Name: "project_type", Type: field.TypeString, Nullable: true},
+		{Name: "build_dir", Type: field.TypeString, Nullable: true},
+		{Name: "cache_status", Type: field.TypeString, Nullable: true},
+		{Name: "url", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_deployments", Type: field.TypeInt, Nullable: true},
+		{Name: "user_deployments", Type: field.TypeInt, Nullable: true},
+	}
+	// DeploymentsTable holds the schema information for the "deployments" table.
+	DeploymentsTable = &schema.Table{
+		Name:       "deployments",
+		Columns:    DeploymentsColumns,
+		PrimaryKey: []*schema.Column{DeploymentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "deployments_projects_deployments",
+				Columns:    []*schema.Column{DeploymentsColumns[15]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "deployments_users_deployments",
+				Columns:    []*schema.Column{DeploymentsColumns[16]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// DomainsColumns holds the columns for the "domains" table.
+	DomainsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hostname", Type: field.TypeString},
+		{Name: "method", Type: field.TypeEnum, Enums: []string{"dns", "http"}, Default: "dns"},
+		{Name: "token", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Default: false},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "project_domains", Type: field.TypeInt},
+	}
+	// DomainsTable holds the schema information for the "domains" table.
+	DomainsTable = &schema.Table{
+		Name:       "domains",
+		Columns:    DomainsColumns,
+		PrimaryKey: []*schema.Column{DomainsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "domains_projects_domains",
+				Columns:    []*schema.Column{DomainsColumns[7]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "domain_hostname_project_domains",
+				Unique:  true,
+				Columns: []*schema.Column{DomainsColumns[1], DomainsColumns[7]},
+			},
+			{
+				Name:    "domain_hostname",
+				Unique:  true,
+				Columns: []*schema.Column{DomainsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "verified",
+				},
+			},
+		},
+	}
+	// EnvVarsColumns holds the columns for the "env_vars" table.
+	EnvVarsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "value", Type: field.TypeBytes},
+		{Name: "environment", Type: field.TypeEnum, Enums: []string{"production", "preview"}, Default: "production"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_env_vars", Type: field.TypeInt},
+	}
+	// EnvVarsTable holds the schema information for the "env_vars" table.
+	EnvVarsTable = &schema.Table{
+		Name:       "env_vars",
+		Columns:    EnvVarsColumns,
+		PrimaryKey: []*schema.Column{EnvVarsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "env_vars_projects_env_vars",
+				Columns:    []*schema.Column{EnvVarsColumns[6]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "envvar_key_environment_project_env_vars",
+				Unique:  true,
+				Columns: []*schema.Column{EnvVarsColumns[1], EnvVarsColumns[3], EnvVarsColumns[6]},
+			},
+		},
+	}
+	// ProjectsColumns holds the columns for the "projects" table.
+	ProjectsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "owner", Type: field.TypeString},
+		{Name: "repo", Type: field.TypeString},
+		{Name: "branch", Type: field.TypeString, Nullable: true},
+		{Name: "project_type", Type: field.TypeString, Nullable: true},
+		{Name: "build_dir", Type: field.TypeString, Nullable: true},
+		{Name: "url", Type: field.TypeString, Nullable: true},
+		{Name: "current_release", Type: field.TypeString, Nullable: true},
+		{Name: "health_check", Type: field.TypeJSON, Nullable: true},
+		{Name: "fork_previews", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_projects", Type: field.TypeInt, Nullable: true},
+	}
+	// ProjectsTable holds the schema information for the "projects" table.
+	ProjectsTable = &schema.Table{
+		Name:       "projects",
+		Columns:    ProjectsColumns,
+		PrimaryKey: []*schema.Column{ProjectsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "projects_users_projects",
+				Columns:    []*schema.Column{ProjectsColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// TasksColumns holds the columns for the "tasks" table.
 	TasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -35,10 +178,19 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		DeploymentsTable,
+		DomainsTable,
+		EnvVarsTable,
+		ProjectsTable,
 		TasksTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	DeploymentsTable.ForeignKeys[0].RefTable = ProjectsTable
+	DeploymentsTable.ForeignKeys[1].RefTable = UsersTable
+	DomainsTable.ForeignKeys[0].RefTable = ProjectsTable
+	EnvVarsTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectsTable.ForeignKeys[0].RefTable = UsersTable
 }
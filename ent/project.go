@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/RajBhut/go-basics/ent/project"
+	"github.com/RajBhut/go-basics/ent/user"
+	"github.com/RajBhut/go-basics/model"
+)
+
+// Project is the model entity for the Project schema.
+type Project struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// Repo holds the value of the "repo" field.
+	Repo string `json:"repo,omitempty"`
+	// Branch holds the value of the "branch" field.
+	Branch string `json:"branch,omitempty"`
+	// ProjectType holds the value of the "project_type" field.
+	ProjectType string `json:"project_type,omitempty"`
+	// BuildDir holds the value of the "build_dir" field.
+	BuildDir string `json:"build_dir,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// CurrentRelease holds the value of the "current_release" field.
+	CurrentRelease string `json:"current_release,omitempty"`
+	// HealthCheck holds the value of the "health_check" field.
+	HealthCheck *model.HealthCheck `json:"health_check,omitempty"`
+	// ForkPreviews holds the value of the "fork_previews" field.
+	ForkPreviews bool `json:"fork_previews,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProjectQuery when eager-loading is set.
+	Edges         ProjectEdges `json:"edges"`
+	user_projects *int
+	selectValues  sql.SelectValues
+}
+
+// ProjectEdges holds the relations/edges for other nodes in the graph.
+type ProjectEdges struct {
+	// Deployments holds the value of the deployments edge.
+	Deployments []*Deployment `json:"deployments,omitempty"`
+	// EnvVars holds the value of the env_vars edge.
+	EnvVars []*EnvVar `json:"env_vars,omitempty"`
+	// Domains holds the value of the domains edge.
+	Domains []*Domain `json:"domains,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// DeploymentsOrErr returns the Deployments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) DeploymentsOrErr() ([]*Deployment, error) {
+	if e.loadedTypes[0] {
+		return e.Deployments, nil
+	}
+	return nil, &NotLoadedError{edge: "deployments"}
+}
+
+// EnvVarsOrErr returns the EnvVars value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) EnvVarsOrErr() ([]*EnvVar, error) {
+	if e.loadedTypes[1] {
+		return e.EnvVars, nil
+	}
+	return nil, &NotLoadedError{edge: "env_vars"}
+}
+
+// DomainsOrErr returns the Domains value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) DomainsOrErr() ([]*Domain, error) {
+	if e.loadedTypes[2] {
+		return e.Domains, nil
+	}
+	return nil, &NotLoadedError{edge: "domains"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProjectEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Project) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case project.FieldHealthCheck:
+			values[i] = new([]byte)
+		case project.FieldForkPreviews:
+			values[i] = new(sql.NullBool)
+		case project.FieldID:
+			values[i] = new(sql.NullInt64)
+		case project.FieldName, project.FieldOwner, project.FieldRepo, project.FieldBranch, project.FieldProjectType, project.FieldBuildDir, project.FieldURL, project.FieldCurrentRelease:
+			values[i] = new(sql.NullString)
+		case project.FieldCreatedAt, project.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case project.ForeignKeys[0]: // user_projects
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Project fields.
+func (pr *Project) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case project.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case project.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pr.Name = value.String
+			}
+		case project.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				pr.Owner = value.String
+			}
+		case project.FieldRepo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field repo", values[i])
+			} else if value.Valid {
+				pr.Repo = value.String
+			}
+		case project.FieldBranch:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field branch", values[i])
+			} else if value.Valid {
+				pr.Branch = value.String
+			}
+		case project.FieldProjectType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project_type", values[i])
+			} else if value.Valid {
+				pr.ProjectType = value.String
+			}
+		case project.FieldBuildDir:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field build_dir", values[i])
+			} else if value.Valid {
+				pr.BuildDir = value.String
+			}
+		case project.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				pr.URL = value.String
+			}
+		case project.FieldCurrentRelease:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field current_release", values[i])
+			} else if value.Valid {
+				pr.CurrentRelease = value.String
+			}
+		case project.FieldHealthCheck:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field health_check", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.HealthCheck); err != nil {
+					return fmt.Errorf("unmarshal field health_check: %w", err)
+				}
+			}
+		case project.FieldForkPreviews:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field fork_previews", values[i])
+			} else if value.Valid {
+				pr.ForkPreviews = value.Bool
+			}
+		case project.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case project.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		case project.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_projects", value)
+			} else if value.Valid {
+				pr.user_projects = new(int)
+				*pr.user_projects = int(value.Int64)
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Project.
+// This includes values selected through modifiers, order, etc.
+func (pr *Project) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryDeployments queries the "deployments" edge of the Project entity.
+func (pr *Project) QueryDeployments() *DeploymentQuery {
+	return NewProjectClient(pr.config).QueryDeployments(pr)
+}
+
+// QueryEnvVars queries the "env_vars" edge of the Project entity.
+func (pr *Project) QueryEnvVars() *EnvVarQuery {
+	return NewProjectClient(pr.config).QueryEnvVars(pr)
+}
+
+// QueryDomains queries the "domains" edge of the Project entity.
+func (pr *Project) QueryDomains() *DomainQuery {
+	return NewProjectClient(pr.config).QueryDomains(pr)
+}
+
+// QueryUser queries the "user" edge of the Project entity.
+func (pr *Project) QueryUser() *UserQuery {
+	return NewProjectClient(pr.config).QueryUser(pr)
+}
+
+// Update returns a builder for updating this Project.
+// Note that you need to call Project.Unwrap() before calling this method if this Project
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *Project) Update() *ProjectUpdateOne {
+	return NewProjectClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the Project entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *Project) Unwrap() *Project {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Project is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *Project) String() string {
+	var builder strings.Builder
+	builder.WriteString("Project(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("name=")
+	builder.WriteString(pr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(pr.Owner)
+	builder.WriteString(", ")
+	builder.WriteString("repo=")
+	builder.WriteString(pr.Repo)
+	builder.WriteString(", ")
+	builder.WriteString("branch=")
+	builder.WriteString(pr.Branch)
+	builder.WriteString(", ")
+	builder.WriteString("project_type=")
+	builder.WriteString(pr.ProjectType)
+	builder.WriteString(", ")
+	builder.WriteString("build_dir=")
+	builder.WriteString(pr.BuildDir)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(pr.URL)
+	builder.WriteString(", ")
+	builder.WriteString("current_release=")
+	builder.WriteString(pr.CurrentRelease)
+	builder.WriteString(", ")
+	builder.WriteString("health_check=")
+	builder.WriteString(fmt.Sprintf("%v", pr.HealthCheck))
+	builder.WriteString(", ")
+	builder.WriteString("fork_previews=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ForkPreviews))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Projects is a parsable slice of Project.
+type Projects []*Project
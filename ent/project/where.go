@@ -0,0 +1,888 @@
+// Code generated by ent, DO NOT EDIT.
+
+package project
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/RajBhut/go-basics/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldName, v))
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldOwner, v))
+}
+
+// Repo applies equality check predicate on the "repo" field. It's identical to RepoEQ.
+func Repo(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldRepo, v))
+}
+
+// Branch applies equality check predicate on the "branch" field. It's identical to BranchEQ.
+func Branch(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldBranch, v))
+}
+
+// ProjectType applies equality check predicate on the "project_type" field. It's identical to ProjectTypeEQ.
+func ProjectType(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldProjectType, v))
+}
+
+// BuildDir applies equality check predicate on the "build_dir" field. It's identical to BuildDirEQ.
+func BuildDir(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldBuildDir, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldURL, v))
+}
+
+// CurrentRelease applies equality check predicate on the "current_release" field. It's identical to CurrentReleaseEQ.
+func CurrentRelease(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldCurrentRelease, v))
+}
+
+// ForkPreviews applies equality check predicate on the "fork_previews" field. It's identical to ForkPreviewsEQ.
+func ForkPreviews(v bool) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldForkPreviews, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldName, v))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldOwner, v))
+}
+
+// RepoEQ applies the EQ predicate on the "repo" field.
+func RepoEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldRepo, v))
+}
+
+// RepoNEQ applies the NEQ predicate on the "repo" field.
+func RepoNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldRepo, v))
+}
+
+// RepoIn applies the In predicate on the "repo" field.
+func RepoIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldRepo, vs...))
+}
+
+// RepoNotIn applies the NotIn predicate on the "repo" field.
+func RepoNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldRepo, vs...))
+}
+
+// RepoGT applies the GT predicate on the "repo" field.
+func RepoGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldRepo, v))
+}
+
+// RepoGTE applies the GTE predicate on the "repo" field.
+func RepoGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldRepo, v))
+}
+
+// RepoLT applies the LT predicate on the "repo" field.
+func RepoLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldRepo, v))
+}
+
+// RepoLTE applies the LTE predicate on the "repo" field.
+func RepoLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldRepo, v))
+}
+
+// RepoContains applies the Contains predicate on the "repo" field.
+func RepoContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldRepo, v))
+}
+
+// RepoHasPrefix applies the HasPrefix predicate on the "repo" field.
+func RepoHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldRepo, v))
+}
+
+// RepoHasSuffix applies the HasSuffix predicate on the "repo" field.
+func RepoHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldRepo, v))
+}
+
+// RepoEqualFold applies the EqualFold predicate on the "repo" field.
+func RepoEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldRepo, v))
+}
+
+// RepoContainsFold applies the ContainsFold predicate on the "repo" field.
+func RepoContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldRepo, v))
+}
+
+// BranchEQ applies the EQ predicate on the "branch" field.
+func BranchEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldBranch, v))
+}
+
+// BranchNEQ applies the NEQ predicate on the "branch" field.
+func BranchNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldBranch, v))
+}
+
+// BranchIn applies the In predicate on the "branch" field.
+func BranchIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldBranch, vs...))
+}
+
+// BranchNotIn applies the NotIn predicate on the "branch" field.
+func BranchNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldBranch, vs...))
+}
+
+// BranchGT applies the GT predicate on the "branch" field.
+func BranchGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldBranch, v))
+}
+
+// BranchGTE applies the GTE predicate on the "branch" field.
+func BranchGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldBranch, v))
+}
+
+// BranchLT applies the LT predicate on the "branch" field.
+func BranchLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldBranch, v))
+}
+
+// BranchLTE applies the LTE predicate on the "branch" field.
+func BranchLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldBranch, v))
+}
+
+// BranchContains applies the Contains predicate on the "branch" field.
+func BranchContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldBranch, v))
+}
+
+// BranchHasPrefix applies the HasPrefix predicate on the "branch" field.
+func BranchHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldBranch, v))
+}
+
+// BranchHasSuffix applies the HasSuffix predicate on the "branch" field.
+func BranchHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldBranch, v))
+}
+
+// BranchIsNil applies the IsNil predicate on the "branch" field.
+func BranchIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldBranch))
+}
+
+// BranchNotNil applies the NotNil predicate on the "branch" field.
+func BranchNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldBranch))
+}
+
+// BranchEqualFold applies the EqualFold predicate on the "branch" field.
+func BranchEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldBranch, v))
+}
+
+// BranchContainsFold applies the ContainsFold predicate on the "branch" field.
+func BranchContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldBranch, v))
+}
+
+// ProjectTypeEQ applies the EQ predicate on the "project_type" field.
+func ProjectTypeEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldProjectType, v))
+}
+
+// ProjectTypeNEQ applies the NEQ predicate on the "project_type" field.
+func ProjectTypeNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldProjectType, v))
+}
+
+// ProjectTypeIn applies the In predicate on the "project_type" field.
+func ProjectTypeIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldProjectType, vs...))
+}
+
+// ProjectTypeNotIn applies the NotIn predicate on the "project_type" field.
+func ProjectTypeNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldProjectType, vs...))
+}
+
+// ProjectTypeGT applies the GT predicate on the "project_type" field.
+func ProjectTypeGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldProjectType, v))
+}
+
+// ProjectTypeGTE applies the GTE predicate on the "project_type" field.
+func ProjectTypeGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldProjectType, v))
+}
+
+// ProjectTypeLT applies the LT predicate on the "project_type" field.
+func ProjectTypeLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldProjectType, v))
+}
+
+// ProjectTypeLTE applies the LTE predicate on the "project_type" field.
+func ProjectTypeLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldProjectType, v))
+}
+
+// ProjectTypeContains applies the Contains predicate on the "project_type" field.
+func ProjectTypeContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldProjectType, v))
+}
+
+// ProjectTypeHasPrefix applies the HasPrefix predicate on the "project_type" field.
+func ProjectTypeHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldProjectType, v))
+}
+
+// ProjectTypeHasSuffix applies the HasSuffix predicate on the "project_type" field.
+func ProjectTypeHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldProjectType, v))
+}
+
+// ProjectTypeIsNil applies the IsNil predicate on the "project_type" field.
+func ProjectTypeIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldProjectType))
+}
+
+// ProjectTypeNotNil applies the NotNil predicate on the "project_type" field.
+func ProjectTypeNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldProjectType))
+}
+
+// ProjectTypeEqualFold applies the EqualFold predicate on the "project_type" field.
+func ProjectTypeEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldProjectType, v))
+}
+
+// ProjectTypeContainsFold applies the ContainsFold predicate on the "project_type" field.
+func ProjectTypeContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldProjectType, v))
+}
+
+// BuildDirEQ applies the EQ predicate on the "build_dir" field.
+func BuildDirEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldBuildDir, v))
+}
+
+// BuildDirNEQ applies the NEQ predicate on the "build_dir" field.
+func BuildDirNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldBuildDir, v))
+}
+
+// BuildDirIn applies the In predicate on the "build_dir" field.
+func BuildDirIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldBuildDir, vs...))
+}
+
+// BuildDirNotIn applies the NotIn predicate on the "build_dir" field.
+func BuildDirNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldBuildDir, vs...))
+}
+
+// BuildDirGT applies the GT predicate on the "build_dir" field.
+func BuildDirGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldBuildDir, v))
+}
+
+// BuildDirGTE applies the GTE predicate on the "build_dir" field.
+func BuildDirGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldBuildDir, v))
+}
+
+// BuildDirLT applies the LT predicate on the "build_dir" field.
+func BuildDirLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldBuildDir, v))
+}
+
+// BuildDirLTE applies the LTE predicate on the "build_dir" field.
+func BuildDirLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldBuildDir, v))
+}
+
+// BuildDirContains applies the Contains predicate on the "build_dir" field.
+func BuildDirContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldBuildDir, v))
+}
+
+// BuildDirHasPrefix applies the HasPrefix predicate on the "build_dir" field.
+func BuildDirHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldBuildDir, v))
+}
+
+// BuildDirHasSuffix applies the HasSuffix predicate on the "build_dir" field.
+func BuildDirHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldBuildDir, v))
+}
+
+// BuildDirIsNil applies the IsNil predicate on the "build_dir" field.
+func BuildDirIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldBuildDir))
+}
+
+// BuildDirNotNil applies the NotNil predicate on the "build_dir" field.
+func BuildDirNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldBuildDir))
+}
+
+// BuildDirEqualFold applies the EqualFold predicate on the "build_dir" field.
+func BuildDirEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldBuildDir, v))
+}
+
+// BuildDirContainsFold applies the ContainsFold predicate on the "build_dir" field.
+func BuildDirContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldBuildDir, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLIsNil applies the IsNil predicate on the "url" field.
+func URLIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldURL))
+}
+
+// URLNotNil applies the NotNil predicate on the "url" field.
+func URLNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldURL))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldURL, v))
+}
+
+// CurrentReleaseEQ applies the EQ predicate on the "current_release" field.
+func CurrentReleaseEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldCurrentRelease, v))
+}
+
+// CurrentReleaseNEQ applies the NEQ predicate on the "current_release" field.
+func CurrentReleaseNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldCurrentRelease, v))
+}
+
+// CurrentReleaseIn applies the In predicate on the "current_release" field.
+func CurrentReleaseIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldCurrentRelease, vs...))
+}
+
+// CurrentReleaseNotIn applies the NotIn predicate on the "current_release" field.
+func CurrentReleaseNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldCurrentRelease, vs...))
+}
+
+// CurrentReleaseGT applies the GT predicate on the "current_release" field.
+func CurrentReleaseGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldCurrentRelease, v))
+}
+
+// CurrentReleaseGTE applies the GTE predicate on the "current_release" field.
+func CurrentReleaseGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldCurrentRelease, v))
+}
+
+// CurrentReleaseLT applies the LT predicate on the "current_release" field.
+func CurrentReleaseLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldCurrentRelease, v))
+}
+
+// CurrentReleaseLTE applies the LTE predicate on the "current_release" field.
+func CurrentReleaseLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldCurrentRelease, v))
+}
+
+// CurrentReleaseContains applies the Contains predicate on the "current_release" field.
+func CurrentReleaseContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldCurrentRelease, v))
+}
+
+// CurrentReleaseHasPrefix applies the HasPrefix predicate on the "current_release" field.
+func CurrentReleaseHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldCurrentRelease, v))
+}
+
+// CurrentReleaseHasSuffix applies the HasSuffix predicate on the "current_release" field.
+func CurrentReleaseHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldCurrentRelease, v))
+}
+
+// CurrentReleaseIsNil applies the IsNil predicate on the "current_release" field.
+func CurrentReleaseIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldCurrentRelease))
+}
+
+// CurrentReleaseNotNil applies the NotNil predicate on the "current_release" field.
+func CurrentReleaseNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldCurrentRelease))
+}
+
+// CurrentReleaseEqualFold applies the EqualFold predicate on the "current_release" field.
+func CurrentReleaseEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldCurrentRelease, v))
+}
+
+// CurrentReleaseContainsFold applies the ContainsFold predicate on the "current_release" field.
+func CurrentReleaseContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldCurrentRelease, v))
+}
+
+// HealthCheckIsNil applies the IsNil predicate on the "health_check" field.
+func HealthCheckIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldHealthCheck))
+}
+
+// HealthCheckNotNil applies the NotNil predicate on the "health_check" field.
+func HealthCheckNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldHealthCheck))
+}
+
+// ForkPreviewsEQ applies the EQ predicate on the "fork_previews" field.
+func ForkPreviewsEQ(v bool) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldForkPreviews, v))
+}
+
+// ForkPreviewsNEQ applies the NEQ predicate on the "fork_previews" field.
+func ForkPreviewsNEQ(v bool) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldForkPreviews, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasDeployments applies the HasEdge predicate on the "deployments" edge.
+func HasDeployments() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeploymentsTable, DeploymentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeploymentsWith applies the HasEdge predicate on the "deployments" edge with a given conditions (other predicates).
+func HasDeploymentsWith(preds ...predicate.Deployment) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newDeploymentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEnvVars applies the HasEdge predicate on the "env_vars" edge.
+func HasEnvVars() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EnvVarsTable, EnvVarsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnvVarsWith applies the HasEdge predicate on the "env_vars" edge with a given conditions (other predicates).
+func HasEnvVarsWith(preds ...predicate.EnvVar) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newEnvVarsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDomains applies the HasEdge predicate on the "domains" edge.
+func HasDomains() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DomainsTable, DomainsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDomainsWith applies the HasEdge predicate on the "domains" edge with a given conditions (other predicates).
+func HasDomainsWith(preds ...predicate.Domain) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newDomainsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Project) predicate.Project {
+	return predicate.Project(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Project) predicate.Project {
+	return predicate.Project(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Project) predicate.Project {
+	return predicate.Project(sql.NotPredicates(p))
+}
@@ -0,0 +1,540 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/RajBhut/go-basics/ent/deployment"
+	"github.com/RajBhut/go-basics/ent/domain"
+	"github.com/RajBhut/go-basics/ent/envvar"
+	"github.com/RajBhut/go-basics/ent/project"
+	"github.com/RajBhut/go-basics/ent/user"
+	"github.com/RajBhut/go-basics/model"
+)
+
+// ProjectCreate is the builder for creating a Project entity.
+type ProjectCreate struct {
+	config
+	mutation *ProjectMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (pc *ProjectCreate) SetName(s string) *ProjectCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetOwner sets the "owner" field.
+func (pc *ProjectCreate) SetOwner(s string) *ProjectCreate {
+	pc.mutation.SetOwner(s)
+	return pc
+}
+
+// SetRepo sets the "repo" field.
+func (pc *ProjectCreate) SetRepo(s string) *ProjectCreate {
+	pc.mutation.SetRepo(s)
+	return pc
+}
+
+// SetBranch sets the "branch" field.
+func (pc *ProjectCreate) SetBranch(s string) *ProjectCreate {
+	pc.mutation.SetBranch(s)
+	return pc
+}
+
+// SetNillableBranch sets the "branch" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableBranch(s *string) *ProjectCreate {
+	if s != nil {
+		pc.SetBranch(*s)
+	}
+	return pc
+}
+
+// SetProjectType sets the "project_type" field.
+func (pc *ProjectCreate) SetProjectType(s string) *ProjectCreate {
+	pc.mutation.SetProjectType(s)
+	return pc
+}
+
+// SetNillableProjectType sets the "project_type" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableProjectType(s *string) *ProjectCreate {
+	if s != nil {
+		pc.SetProjectType(*s)
+	}
+	return pc
+}
+
+// SetBuildDir sets the "build_dir" field.
+func (pc *ProjectCreate) SetBuildDir(s string) *ProjectCreate {
+	pc.mutation.SetBuildDir(s)
+	return pc
+}
+
+// SetNillableBuildDir sets the "build_dir" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableBuildDir(s *string) *ProjectCreate {
+	if s != nil {
+		pc.SetBuildDir(*s)
+	}
+	return pc
+}
+
+// SetURL sets the "url" field.
+func (pc *ProjectCreate) SetURL(s string) *ProjectCreate {
+	pc.mutation.SetURL(s)
+	return pc
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableURL(s *string) *ProjectCreate {
+	if s != nil {
+		pc.SetURL(*s)
+	}
+	return pc
+}
+
+// SetCurrentRelease sets the "current_release" field.
+func (pc *ProjectCreate) SetCurrentRelease(s string) *ProjectCreate {
+	pc.mutation.SetCurrentRelease(s)
+	return pc
+}
+
+// SetNillableCurrentRelease sets the "current_release" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableCurrentRelease(s *string) *ProjectCreate {
+	if s != nil {
+		pc.SetCurrentRelease(*s)
+	}
+	return pc
+}
+
+// SetHealthCheck sets the "health_check" field.
+func (pc *ProjectCreate) SetHealthCheck(mc *model.HealthCheck) *ProjectCreate {
+	pc.mutation.SetHealthCheck(mc)
+	return pc
+}
+
+// SetForkPreviews sets the "fork_previews" field.
+func (pc *ProjectCreate) SetForkPreviews(b bool) *ProjectCreate {
+	pc.mutation.SetForkPreviews(b)
+	return pc
+}
+
+// SetNillableForkPreviews sets the "fork_previews" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableForkPreviews(b *bool) *ProjectCreate {
+	if b != nil {
+		pc.SetForkPreviews(*b)
+	}
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *ProjectCreate) SetCreatedAt(t time.Time) *ProjectCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableCreatedAt(t *time.Time) *ProjectCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *ProjectCreate) SetUpdatedAt(t time.Time) *ProjectCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableUpdatedAt(t *time.Time) *ProjectCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// AddDeploymentIDs adds the "deployments" edge to the Deployment entity by IDs.
+func (pc *ProjectCreate) AddDeploymentIDs(ids ...string) *ProjectCreate {
+	pc.mutation.AddDeploymentIDs(ids...)
+	return pc
+}
+
+// AddDeployments adds the "deployments" edges to the Deployment entity.
+func (pc *ProjectCreate) AddDeployments(d ...*Deployment) *ProjectCreate {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pc.AddDeploymentIDs(ids...)
+}
+
+// AddEnvVarIDs adds the "env_vars" edge to the EnvVar entity by IDs.
+func (pc *ProjectCreate) AddEnvVarIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddEnvVarIDs(ids...)
+	return pc
+}
+
+// AddEnvVars adds the "env_vars" edges to the EnvVar entity.
+func (pc *ProjectCreate) AddEnvVars(e ...*EnvVar) *ProjectCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return pc.AddEnvVarIDs(ids...)
+}
+
+// AddDomainIDs adds the "domains" edge to the Domain entity by IDs.
+func (pc *ProjectCreate) AddDomainIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddDomainIDs(ids...)
+	return pc
+}
+
+// AddDomains adds the "domains" edges to the Domain entity.
+func (pc *ProjectCreate) AddDomains(d ...*Domain) *ProjectCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pc.AddDomainIDs(ids...)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (pc *ProjectCreate) SetUserID(id int) *ProjectCreate {
+	pc.mutation.SetUserID(id)
+	return pc
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (pc *ProjectCreate) SetNillableUserID(id *int) *ProjectCreate {
+	if id != nil {
+		pc = pc.SetUserID(*id)
+	}
+	return pc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pc *ProjectCreate) SetUser(u *User) *ProjectCreate {
+	return pc.SetUserID(u.ID)
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (pc *ProjectCreate) Mutation() *ProjectMutation {
+	return pc.mutation
+}
+
+// Save creates the Project in the database.
+func (pc *ProjectCreate) Save(ctx context.Context) (*Project, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *ProjectCreate) SaveX(ctx context.Context) *Project {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *ProjectCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *ProjectCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *ProjectCreate) defaults() {
+	if _, ok := pc.mutation.ForkPreviews(); !ok {
+		v := project.DefaultForkPreviews
+		pc.mutation.SetForkPreviews(v)
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := project.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := project.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *ProjectCreate) check() error {
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Project.name"`)}
+	}
+	if v, ok := pc.mutation.Name(); ok {
+		if err := project.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Project.name": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Owner(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required field "Project.owner"`)}
+	}
+	if v, ok := pc.mutation.Owner(); ok {
+		if err := project.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Project.owner": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Repo(); !ok {
+		return &ValidationError{Name: "repo", err: errors.New(`ent: missing required field "Project.repo"`)}
+	}
+	if v, ok := pc.mutation.Repo(); ok {
+		if err := project.RepoValidator(v); err != nil {
+			return &ValidationError{Name: "repo", err: fmt.Errorf(`ent: validator failed for field "Project.repo": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.ForkPreviews(); !ok {
+		return &ValidationError{Name: "fork_previews", err: errors.New(`ent: missing required field "Project.fork_previews"`)}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Project.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Project.updated_at"`)}
+	}
+	return nil
+}
+
+func (pc *ProjectCreate) sqlSave(ctx context.Context) (*Project, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Project{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(project.Table, sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt))
+	)
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.SetField(project.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pc.mutation.Owner(); ok {
+		_spec.SetField(project.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := pc.mutation.Repo(); ok {
+		_spec.SetField(project.FieldRepo, field.TypeString, value)
+		_node.Repo = value
+	}
+	if value, ok := pc.mutation.Branch(); ok {
+		_spec.SetField(project.FieldBranch, field.TypeString, value)
+		_node.Branch = value
+	}
+	if value, ok := pc.mutation.ProjectType(); ok {
+		_spec.SetField(project.FieldProjectType, field.TypeString, value)
+		_node.ProjectType = value
+	}
+	if value, ok := pc.mutation.BuildDir(); ok {
+		_spec.SetField(project.FieldBuildDir, field.TypeString, value)
+		_node.BuildDir = value
+	}
+	if value, ok := pc.mutation.URL(); ok {
+		_spec.SetField(project.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := pc.mutation.CurrentRelease(); ok {
+		_spec.SetField(project.FieldCurrentRelease, field.TypeString, value)
+		_node.CurrentRelease = value
+	}
+	if value, ok := pc.mutation.HealthCheck(); ok {
+		_spec.SetField(project.FieldHealthCheck, field.TypeJSON, value)
+		_node.HealthCheck = value
+	}
+	if value, ok := pc.mutation.ForkPreviews(); ok {
+		_spec.SetField(project.FieldForkPreviews, field.TypeBool, value)
+		_node.ForkPreviews = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(project.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(project.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pc.mutation.DeploymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DeploymentsTable,
+			Columns: []string{project.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.EnvVarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.EnvVarsTable,
+			Columns: []string{project.EnvVarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envvar.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.DomainsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DomainsTable,
+			Columns: []string{project.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.UserTable,
+			Columns: []string{project.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_projects = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProjectCreateBulk is the builder for creating many Project entities in bulk.
+type ProjectCreateBulk struct {
+	config
+	err      error
+	builders []*ProjectCreate
+}
+
+// Save creates the Project entities in the database.
+func (pcb *ProjectCreateBulk) Save(ctx context.Context) ([]*Project, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Project, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProjectMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *ProjectCreateBulk) SaveX(ctx context.Context) []*Project {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *ProjectCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *ProjectCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
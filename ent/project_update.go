@@ -0,0 +1,1289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/RajBhut/go-basics/ent/deployment"
+	"github.com/RajBhut/go-basics/ent/domain"
+	"github.com/RajBhut/go-basics/ent/envvar"
+	"github.com/RajBhut/go-basics/ent/predicate"
+	"github.com/RajBhut/go-basics/ent/project"
+	"github.com/RajBhut/go-basics/ent/user"
+	"github.com/RajBhut/go-basics/model"
+)
+
+// ProjectUpdate is the builder for updating Project entities.
+type ProjectUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProjectMutation
+}
+
+// Where appends a list predicates to the ProjectUpdate builder.
+func (pu *ProjectUpdate) Where(ps ...predicate.Project) *ProjectUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *ProjectUpdate) SetName(s string) *ProjectUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableName(s *string) *ProjectUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// SetOwner sets the "owner" field.
+func (pu *ProjectUpdate) SetOwner(s string) *ProjectUpdate {
+	pu.mutation.SetOwner(s)
+	return pu
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableOwner(s *string) *ProjectUpdate {
+	if s != nil {
+		pu.SetOwner(*s)
+	}
+	return pu
+}
+
+// SetRepo sets the "repo" field.
+func (pu *ProjectUpdate) SetRepo(s string) *ProjectUpdate {
+	pu.mutation.SetRepo(s)
+	return pu
+}
+
+// SetNillableRepo sets the "repo" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableRepo(s *string) *ProjectUpdate {
+	if s != nil {
+		pu.SetRepo(*s)
+	}
+	return pu
+}
+
+// SetBranch sets the "branch" field.
+func (pu *ProjectUpdate) SetBranch(s string) *ProjectUpdate {
+	pu.mutation.SetBranch(s)
+	return pu
+}
+
+// SetNillableBranch sets the "branch" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableBranch(s *string) *ProjectUpdate {
+	if s != nil {
+		pu.SetBranch(*s)
+	}
+	return pu
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (pu *ProjectUpdate) ClearBranch() *ProjectUpdate {
+	pu.mutation.ClearBranch()
+	return pu
+}
+
+// SetProjectType sets the "project_type" field.
+func (pu *ProjectUpdate) SetProjectType(s string) *ProjectUpdate {
+	pu.mutation.SetProjectType(s)
+	return pu
+}
+
+// SetNillableProjectType sets the "project_type" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableProjectType(s *string) *ProjectUpdate {
+	if s != nil {
+		pu.SetProjectType(*s)
+	}
+	return pu
+}
+
+// ClearProjectType clears the value of the "project_type" field.
+func (pu *ProjectUpdate) ClearProjectType() *ProjectUpdate {
+	pu.mutation.ClearProjectType()
+	return pu
+}
+
+// SetBuildDir sets the "build_dir" field.
+func (pu *ProjectUpdate) SetBuildDir(s string) *ProjectUpdate {
+	pu.mutation.SetBuildDir(s)
+	return pu
+}
+
+// SetNillableBuildDir sets the "build_dir" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableBuildDir(s *string) *ProjectUpdate {
+	if s != nil {
+		pu.SetBuildDir(*s)
+	}
+	return pu
+}
+
+// ClearBuildDir clears the value of the "build_dir" field.
+func (pu *ProjectUpdate) ClearBuildDir() *ProjectUpdate {
+	pu.mutation.ClearBuildDir()
+	return pu
+}
+
+// SetURL sets the "url" field.
+func (pu *ProjectUpdate) SetURL(s string) *ProjectUpdate {
+	pu.mutation.SetURL(s)
+	return pu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableURL(s *string) *ProjectUpdate {
+	if s != nil {
+		pu.SetURL(*s)
+	}
+	return pu
+}
+
+// ClearURL clears the value of the "url" field.
+func (pu *ProjectUpdate) ClearURL() *ProjectUpdate {
+	pu.mutation.ClearURL()
+	return pu
+}
+
+// SetCurrentRelease sets the "current_release" field.
+func (pu *ProjectUpdate) SetCurrentRelease(s string) *ProjectUpdate {
+	pu.mutation.SetCurrentRelease(s)
+	return pu
+}
+
+// SetNillableCurrentRelease sets the "current_release" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableCurrentRelease(s *string) *ProjectUpdate {
+	if s != nil {
+		pu.SetCurrentRelease(*s)
+	}
+	return pu
+}
+
+// ClearCurrentRelease clears the value of the "current_release" field.
+func (pu *ProjectUpdate) ClearCurrentRelease() *ProjectUpdate {
+	pu.mutation.ClearCurrentRelease()
+	return pu
+}
+
+// SetHealthCheck sets the "health_check" field.
+func (pu *ProjectUpdate) SetHealthCheck(mc *model.HealthCheck) *ProjectUpdate {
+	pu.mutation.SetHealthCheck(mc)
+	return pu
+}
+
+// ClearHealthCheck clears the value of the "health_check" field.
+func (pu *ProjectUpdate) ClearHealthCheck() *ProjectUpdate {
+	pu.mutation.ClearHealthCheck()
+	return pu
+}
+
+// SetForkPreviews sets the "fork_previews" field.
+func (pu *ProjectUpdate) SetForkPreviews(b bool) *ProjectUpdate {
+	pu.mutation.SetForkPreviews(b)
+	return pu
+}
+
+// SetNillableForkPreviews sets the "fork_previews" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableForkPreviews(b *bool) *ProjectUpdate {
+	if b != nil {
+		pu.SetForkPreviews(*b)
+	}
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *ProjectUpdate) SetUpdatedAt(t time.Time) *ProjectUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// AddDeploymentIDs adds the "deployments" edge to the Deployment entity by IDs.
+func (pu *ProjectUpdate) AddDeploymentIDs(ids ...string) *ProjectUpdate {
+	pu.mutation.AddDeploymentIDs(ids...)
+	return pu
+}
+
+// AddDeployments adds the "deployments" edges to the Deployment entity.
+func (pu *ProjectUpdate) AddDeployments(d ...*Deployment) *ProjectUpdate {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pu.AddDeploymentIDs(ids...)
+}
+
+// AddEnvVarIDs adds the "env_vars" edge to the EnvVar entity by IDs.
+func (pu *ProjectUpdate) AddEnvVarIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddEnvVarIDs(ids...)
+	return pu
+}
+
+// AddEnvVars adds the "env_vars" edges to the EnvVar entity.
+func (pu *ProjectUpdate) AddEnvVars(e ...*EnvVar) *ProjectUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return pu.AddEnvVarIDs(ids...)
+}
+
+// AddDomainIDs adds the "domains" edge to the Domain entity by IDs.
+func (pu *ProjectUpdate) AddDomainIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddDomainIDs(ids...)
+	return pu
+}
+
+// AddDomains adds the "domains" edges to the Domain entity.
+func (pu *ProjectUpdate) AddDomains(d ...*Domain) *ProjectUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pu.AddDomainIDs(ids...)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (pu *ProjectUpdate) SetUserID(id int) *ProjectUpdate {
+	pu.mutation.SetUserID(id)
+	return pu
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableUserID(id *int) *ProjectUpdate {
+	if id != nil {
+		pu = pu.SetUserID(*id)
+	}
+	return pu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pu *ProjectUpdate) SetUser(u *User) *ProjectUpdate {
+	return pu.SetUserID(u.ID)
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (pu *ProjectUpdate) Mutation() *ProjectMutation {
+	return pu.mutation
+}
+
+// ClearDeployments clears all "deployments" edges to the Deployment entity.
+func (pu *ProjectUpdate) ClearDeployments() *ProjectUpdate {
+	pu.mutation.ClearDeployments()
+	return pu
+}
+
+// RemoveDeploymentIDs removes the "deployments" edge to Deployment entities by IDs.
+func (pu *ProjectUpdate) RemoveDeploymentIDs(ids ...string) *ProjectUpdate {
+	pu.mutation.RemoveDeploymentIDs(ids...)
+	return pu
+}
+
+// RemoveDeployments removes "deployments" edges to Deployment entities.
+func (pu *ProjectUpdate) RemoveDeployments(d ...*Deployment) *ProjectUpdate {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pu.RemoveDeploymentIDs(ids...)
+}
+
+// ClearEnvVars clears all "env_vars" edges to the EnvVar entity.
+func (pu *ProjectUpdate) ClearEnvVars() *ProjectUpdate {
+	pu.mutation.ClearEnvVars()
+	return pu
+}
+
+// RemoveEnvVarIDs removes the "env_vars" edge to EnvVar entities by IDs.
+func (pu *ProjectUpdate) RemoveEnvVarIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveEnvVarIDs(ids...)
+	return pu
+}
+
+// RemoveEnvVars removes "env_vars" edges to EnvVar entities.
+func (pu *ProjectUpdate) RemoveEnvVars(e ...*EnvVar) *ProjectUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return pu.RemoveEnvVarIDs(ids...)
+}
+
+// ClearDomains clears all "domains" edges to the Domain entity.
+func (pu *ProjectUpdate) ClearDomains() *ProjectUpdate {
+	pu.mutation.ClearDomains()
+	return pu
+}
+
+// RemoveDomainIDs removes the "domains" edge to Domain entities by IDs.
+func (pu *ProjectUpdate) RemoveDomainIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveDomainIDs(ids...)
+	return pu
+}
+
+// RemoveDomains removes "domains" edges to Domain entities.
+func (pu *ProjectUpdate) RemoveDomains(d ...*Domain) *ProjectUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pu.RemoveDomainIDs(ids...)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (pu *ProjectUpdate) ClearUser() *ProjectUpdate {
+	pu.mutation.ClearUser()
+	return pu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *ProjectUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *ProjectUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *ProjectUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *ProjectUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *ProjectUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := project.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *ProjectUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := project.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Project.name": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Owner(); ok {
+		if err := project.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Project.owner": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Repo(); ok {
+		if err := project.RepoValidator(v); err != nil {
+			return &ValidationError{Name: "repo", err: fmt.Errorf(`ent: validator failed for field "Project.repo": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(project.Table, project.Columns, sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.SetField(project.FieldName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Owner(); ok {
+		_spec.SetField(project.FieldOwner, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Repo(); ok {
+		_spec.SetField(project.FieldRepo, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Branch(); ok {
+		_spec.SetField(project.FieldBranch, field.TypeString, value)
+	}
+	if pu.mutation.BranchCleared() {
+		_spec.ClearField(project.FieldBranch, field.TypeString)
+	}
+	if value, ok := pu.mutation.ProjectType(); ok {
+		_spec.SetField(project.FieldProjectType, field.TypeString, value)
+	}
+	if pu.mutation.ProjectTypeCleared() {
+		_spec.ClearField(project.FieldProjectType, field.TypeString)
+	}
+	if value, ok := pu.mutation.BuildDir(); ok {
+		_spec.SetField(project.FieldBuildDir, field.TypeString, value)
+	}
+	if pu.mutation.BuildDirCleared() {
+		_spec.ClearField(project.FieldBuildDir, field.TypeString)
+	}
+	if value, ok := pu.mutation.URL(); ok {
+		_spec.SetField(project.FieldURL, field.TypeString, value)
+	}
+	if pu.mutation.URLCleared() {
+		_spec.ClearField(project.FieldURL, field.TypeString)
+	}
+	if value, ok := pu.mutation.CurrentRelease(); ok {
+		_spec.SetField(project.FieldCurrentRelease, field.TypeString, value)
+	}
+	if pu.mutation.CurrentReleaseCleared() {
+		_spec.ClearField(project.FieldCurrentRelease, field.TypeString)
+	}
+	if value, ok := pu.mutation.HealthCheck(); ok {
+		_spec.SetField(project.FieldHealthCheck, field.TypeJSON, value)
+	}
+	if pu.mutation.HealthCheckCleared() {
+		_spec.ClearField(project.FieldHealthCheck, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.ForkPreviews(); ok {
+		_spec.SetField(project.FieldForkPreviews, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(project.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pu.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DeploymentsTable,
+			Columns: []string{project.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedDeploymentsIDs(); len(nodes) > 0 && !pu.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DeploymentsTable,
+			Columns: []string{project.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.DeploymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DeploymentsTable,
+			Columns: []string{project.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.EnvVarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.EnvVarsTable,
+			Columns: []string{project.EnvVarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envvar.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedEnvVarsIDs(); len(nodes) > 0 && !pu.mutation.EnvVarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.EnvVarsTable,
+			Columns: []string{project.EnvVarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envvar.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.EnvVarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.EnvVarsTable,
+			Columns: []string{project.EnvVarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envvar.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.DomainsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DomainsTable,
+			Columns: []string{project.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedDomainsIDs(); len(nodes) > 0 && !pu.mutation.DomainsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DomainsTable,
+			Columns: []string{project.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.DomainsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DomainsTable,
+			Columns: []string{project.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.UserTable,
+			Columns: []string{project.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.UserTable,
+			Columns: []string{project.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{project.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// ProjectUpdateOne is the builder for updating a single Project entity.
+type ProjectUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProjectMutation
+}
+
+// SetName sets the "name" field.
+func (puo *ProjectUpdateOne) SetName(s string) *ProjectUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableName(s *string) *ProjectUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetOwner sets the "owner" field.
+func (puo *ProjectUpdateOne) SetOwner(s string) *ProjectUpdateOne {
+	puo.mutation.SetOwner(s)
+	return puo
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableOwner(s *string) *ProjectUpdateOne {
+	if s != nil {
+		puo.SetOwner(*s)
+	}
+	return puo
+}
+
+// SetRepo sets the "repo" field.
+func (puo *ProjectUpdateOne) SetRepo(s string) *ProjectUpdateOne {
+	puo.mutation.SetRepo(s)
+	return puo
+}
+
+// SetNillableRepo sets the "repo" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableRepo(s *string) *ProjectUpdateOne {
+	if s != nil {
+		puo.SetRepo(*s)
+	}
+	return puo
+}
+
+// SetBranch sets the "branch" field.
+func (puo *ProjectUpdateOne) SetBranch(s string) *ProjectUpdateOne {
+	puo.mutation.SetBranch(s)
+	return puo
+}
+
+// SetNillableBranch sets the "branch" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableBranch(s *string) *ProjectUpdateOne {
+	if s != nil {
+		puo.SetBranch(*s)
+	}
+	return puo
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (puo *ProjectUpdateOne) ClearBranch() *ProjectUpdateOne {
+	puo.mutation.ClearBranch()
+	return puo
+}
+
+// SetProjectType sets the "project_type" field.
+func (puo *ProjectUpdateOne) SetProjectType(s string) *ProjectUpdateOne {
+	puo.mutation.SetProjectType(s)
+	return puo
+}
+
+// SetNillableProjectType sets the "project_type" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableProjectType(s *string) *ProjectUpdateOne {
+	if s != nil {
+		puo.SetProjectType(*s)
+	}
+	return puo
+}
+
+// ClearProjectType clears the value of the "project_type" field.
+func (puo *ProjectUpdateOne) ClearProjectType() *ProjectUpdateOne {
+	puo.mutation.ClearProjectType()
+	return puo
+}
+
+// SetBuildDir sets the "build_dir" field.
+func (puo *ProjectUpdateOne) SetBuildDir(s string) *ProjectUpdateOne {
+	puo.mutation.SetBuildDir(s)
+	return puo
+}
+
+// SetNillableBuildDir sets the "build_dir" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableBuildDir(s *string) *ProjectUpdateOne {
+	if s != nil {
+		puo.SetBuildDir(*s)
+	}
+	return puo
+}
+
+// ClearBuildDir clears the value of the "build_dir" field.
+func (puo *ProjectUpdateOne) ClearBuildDir() *ProjectUpdateOne {
+	puo.mutation.ClearBuildDir()
+	return puo
+}
+
+// SetURL sets the "url" field.
+func (puo *ProjectUpdateOne) SetURL(s string) *ProjectUpdateOne {
+	puo.mutation.SetURL(s)
+	return puo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableURL(s *string) *ProjectUpdateOne {
+	if s != nil {
+		puo.SetURL(*s)
+	}
+	return puo
+}
+
+// ClearURL clears the value of the "url" field.
+func (puo *ProjectUpdateOne) ClearURL() *ProjectUpdateOne {
+	puo.mutation.ClearURL()
+	return puo
+}
+
+// SetCurrentRelease sets the "current_release" field.
+func (puo *ProjectUpdateOne) SetCurrentRelease(s string) *ProjectUpdateOne {
+	puo.mutation.SetCurrentRelease(s)
+	return puo
+}
+
+// SetNillableCurrentRelease sets the "current_release" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableCurrentRelease(s *string) *ProjectUpdateOne {
+	if s != nil {
+		puo.SetCurrentRelease(*s)
+	}
+	return puo
+}
+
+// ClearCurrentRelease clears the value of the "current_release" field.
+func (puo *ProjectUpdateOne) ClearCurrentRelease() *ProjectUpdateOne {
+	puo.mutation.ClearCurrentRelease()
+	return puo
+}
+
+// SetHealthCheck sets the "health_check" field.
+func (puo *ProjectUpdateOne) SetHealthCheck(mc *model.HealthCheck) *ProjectUpdateOne {
+	puo.mutation.SetHealthCheck(mc)
+	return puo
+}
+
+// ClearHealthCheck clears the value of the "health_check" field.
+func (puo *ProjectUpdateOne) ClearHealthCheck() *ProjectUpdateOne {
+	puo.mutation.ClearHealthCheck()
+	return puo
+}
+
+// SetForkPreviews sets the "fork_previews" field.
+func (puo *ProjectUpdateOne) SetForkPreviews(b bool) *ProjectUpdateOne {
+	puo.mutation.SetForkPreviews(b)
+	return puo
+}
+
+// SetNillableForkPreviews sets the "fork_previews" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableForkPreviews(b *bool) *ProjectUpdateOne {
+	if b != nil {
+		puo.SetForkPreviews(*b)
+	}
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *ProjectUpdateOne) SetUpdatedAt(t time.Time) *ProjectUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// AddDeploymentIDs adds the "deployments" edge to the Deployment entity by IDs.
+func (puo *ProjectUpdateOne) AddDeploymentIDs(ids ...string) *ProjectUpdateOne {
+	puo.mutation.AddDeploymentIDs(ids...)
+	return puo
+}
+
+// AddDeployments adds the "deployments" edges to the Deployment entity.
+func (puo *ProjectUpdateOne) AddDeployments(d ...*Deployment) *ProjectUpdateOne {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return puo.AddDeploymentIDs(ids...)
+}
+
+// AddEnvVarIDs adds the "env_vars" edge to the EnvVar entity by IDs.
+func (puo *ProjectUpdateOne) AddEnvVarIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddEnvVarIDs(ids...)
+	return puo
+}
+
+// AddEnvVars adds the "env_vars" edges to the EnvVar entity.
+func (puo *ProjectUpdateOne) AddEnvVars(e ...*EnvVar) *ProjectUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return puo.AddEnvVarIDs(ids...)
+}
+
+// AddDomainIDs adds the "domains" edge to the Domain entity by IDs.
+func (puo *ProjectUpdateOne) AddDomainIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddDomainIDs(ids...)
+	return puo
+}
+
+// AddDomains adds the "domains" edges to the Domain entity.
+func (puo *ProjectUpdateOne) AddDomains(d ...*Domain) *ProjectUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return puo.AddDomainIDs(ids...)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (puo *ProjectUpdateOne) SetUserID(id int) *ProjectUpdateOne {
+	puo.mutation.SetUserID(id)
+	return puo
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableUserID(id *int) *ProjectUpdateOne {
+	if id != nil {
+		puo = puo.SetUserID(*id)
+	}
+	return puo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (puo *ProjectUpdateOne) SetUser(u *User) *ProjectUpdateOne {
+	return puo.SetUserID(u.ID)
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (puo *ProjectUpdateOne) Mutation() *ProjectMutation {
+	return puo.mutation
+}
+
+// ClearDeployments clears all "deployments" edges to the Deployment entity.
+func (puo *ProjectUpdateOne) ClearDeployments() *ProjectUpdateOne {
+	puo.mutation.ClearDeployments()
+	return puo
+}
+
+// RemoveDeploymentIDs removes the "deployments" edge to Deployment entities by IDs.
+func (puo *ProjectUpdateOne) RemoveDeploymentIDs(ids ...string) *ProjectUpdateOne {
+	puo.mutation.RemoveDeploymentIDs(ids...)
+	return puo
+}
+
+// RemoveDeployments removes "deployments" edges to Deployment entities.
+func (puo *ProjectUpdateOne) RemoveDeployments(d ...*Deployment) *ProjectUpdateOne {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return puo.RemoveDeploymentIDs(ids...)
+}
+
+// ClearEnvVars clears all "env_vars" edges to the EnvVar entity.
+func (puo *ProjectUpdateOne) ClearEnvVars() *ProjectUpdateOne {
+	puo.mutation.ClearEnvVars()
+	return puo
+}
+
+// RemoveEnvVarIDs removes the "env_vars" edge to EnvVar entities by IDs.
+func (puo *ProjectUpdateOne) RemoveEnvVarIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveEnvVarIDs(ids...)
+	return puo
+}
+
+// RemoveEnvVars removes "env_vars" edges to EnvVar entities.
+func (puo *ProjectUpdateOne) RemoveEnvVars(e ...*EnvVar) *ProjectUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return puo.RemoveEnvVarIDs(ids...)
+}
+
+// ClearDomains clears all "domains" edges to the Domain entity.
+func (puo *ProjectUpdateOne) ClearDomains() *ProjectUpdateOne {
+	puo.mutation.ClearDomains()
+	return puo
+}
+
+// RemoveDomainIDs removes the "domains" edge to Domain entities by IDs.
+func (puo *ProjectUpdateOne) RemoveDomainIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveDomainIDs(ids...)
+	return puo
+}
+
+// RemoveDomains removes "domains" edges to Domain entities.
+func (puo *ProjectUpdateOne) RemoveDomains(d ...*Domain) *ProjectUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return puo.RemoveDomainIDs(ids...)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (puo *ProjectUpdateOne) ClearUser() *ProjectUpdateOne {
+	puo.mutation.ClearUser()
+	return puo
+}
+
+// Where appends a list predicates to the ProjectUpdate builder.
+func (puo *ProjectUpdateOne) Where(ps ...predicate.Project) *ProjectUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *ProjectUpdateOne) Select(field string, fields ...string) *ProjectUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Project entity.
+func (puo *ProjectUpdateOne) Save(ctx context.Context) (*Project, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *ProjectUpdateOne) SaveX(ctx context.Context) *Project {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *ProjectUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *ProjectUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *ProjectUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := project.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *ProjectUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := project.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Project.name": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Owner(); ok {
+		if err := project.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Project.owner": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Repo(); ok {
+		if err := project.RepoValidator(v); err != nil {
+			return &ValidationError{Name: "repo", err: fmt.Errorf(`ent: validator failed for field "Project.repo": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(project.Table, project.Columns, sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Project.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, project.FieldID)
+		for _, f := range fields {
+			if !project.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != project.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(project.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Owner(); ok {
+		_spec.SetField(project.FieldOwner, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Repo(); ok {
+		_spec.SetField(project.FieldRepo, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Branch(); ok {
+		_spec.SetField(project.FieldBranch, field.TypeString, value)
+	}
+	if puo.mutation.BranchCleared() {
+		_spec.ClearField(project.FieldBranch, field.TypeString)
+	}
+	if value, ok := puo.mutation.ProjectType(); ok {
+		_spec.SetField(project.FieldProjectType, field.TypeString, value)
+	}
+	if puo.mutation.ProjectTypeCleared() {
+		_spec.ClearField(project.FieldProjectType, field.TypeString)
+	}
+	if value, ok := puo.mutation.BuildDir(); ok {
+		_spec.SetField(project.FieldBuildDir, field.TypeString, value)
+	}
+	if puo.mutation.BuildDirCleared() {
+		_spec.ClearField(project.FieldBuildDir, field.TypeString)
+	}
+	if value, ok := puo.mutation.URL(); ok {
+		_spec.SetField(project.FieldURL, field.TypeString, value)
+	}
+	if puo.mutation.URLCleared() {
+		_spec.ClearField(project.FieldURL, field.TypeString)
+	}
+	if value, ok := puo.mutation.CurrentRelease(); ok {
+		_spec.SetField(project.FieldCurrentRelease, field.TypeString, value)
+	}
+	if puo.mutation.CurrentReleaseCleared() {
+		_spec.ClearField(project.FieldCurrentRelease, field.TypeString)
+	}
+	if value, ok := puo.mutation.HealthCheck(); ok {
+		_spec.SetField(project.FieldHealthCheck, field.TypeJSON, value)
+	}
+	if puo.mutation.HealthCheckCleared() {
+		_spec.ClearField(project.FieldHealthCheck, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.ForkPreviews(); ok {
+		_spec.SetField(project.FieldForkPreviews, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(project.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DeploymentsTable,
+			Columns: []string{project.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedDeploymentsIDs(); len(nodes) > 0 && !puo.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DeploymentsTable,
+			Columns: []string{project.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.DeploymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DeploymentsTable,
+			Columns: []string{project.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.EnvVarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.EnvVarsTable,
+			Columns: []string{project.EnvVarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envvar.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedEnvVarsIDs(); len(nodes) > 0 && !puo.mutation.EnvVarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.EnvVarsTable,
+			Columns: []string{project.EnvVarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envvar.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.EnvVarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.EnvVarsTable,
+			Columns: []string{project.EnvVarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envvar.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.DomainsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DomainsTable,
+			Columns: []string{project.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedDomainsIDs(); len(nodes) > 0 && !puo.mutation.DomainsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DomainsTable,
+			Columns: []string{project.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.DomainsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DomainsTable,
+			Columns: []string{project.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.UserTable,
+			Columns: []string{project.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.UserTable,
+			Columns: []string{project.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Project{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{project.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
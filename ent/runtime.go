@@ -3,6 +3,12 @@
 package ent
 
 import (
+	"time"
+
+	"github.com/RajBhut/go-basics/ent/deployment"
+	"github.com/RajBhut/go-basics/ent/domain"
+	"github.com/RajBhut/go-basics/ent/envvar"
+	"github.com/RajBhut/go-basics/ent/project"
 	"github.com/RajBhut/go-basics/ent/schema"
 	"github.com/RajBhut/go-basics/ent/user"
 )
@@ -11,6 +17,92 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	deploymentFields := schema.Deployment{}.Fields()
+	_ = deploymentFields
+	// deploymentDescOwner is the schema descriptor for owner field.
+	deploymentDescOwner := deploymentFields[1].Descriptor()
+	// deployment.OwnerValidator is a validator for the "owner" field. It is called by the builders before save.
+	deployment.OwnerValidator = deploymentDescOwner.Validators[0].(func(string) error)
+	// deploymentDescRepo is the schema descriptor for repo field.
+	deploymentDescRepo := deploymentFields[2].Descriptor()
+	// deployment.RepoValidator is a validator for the "repo" field. It is called by the builders before save.
+	deployment.RepoValidator = deploymentDescRepo.Validators[0].(func(string) error)
+	// deploymentDescEnvironment is the schema descriptor for environment field.
+	deploymentDescEnvironment := deploymentFields[4].Descriptor()
+	// deployment.DefaultEnvironment holds the default value on creation for the environment field.
+	deployment.DefaultEnvironment = deploymentDescEnvironment.Default.(string)
+	// deploymentDescCreatedAt is the schema descriptor for created_at field.
+	deploymentDescCreatedAt := deploymentFields[13].Descriptor()
+	// deployment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deployment.DefaultCreatedAt = deploymentDescCreatedAt.Default.(func() time.Time)
+	// deploymentDescUpdatedAt is the schema descriptor for updated_at field.
+	deploymentDescUpdatedAt := deploymentFields[14].Descriptor()
+	// deployment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deployment.DefaultUpdatedAt = deploymentDescUpdatedAt.Default.(func() time.Time)
+	// deployment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deployment.UpdateDefaultUpdatedAt = deploymentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	domainFields := schema.Domain{}.Fields()
+	_ = domainFields
+	// domainDescHostname is the schema descriptor for hostname field.
+	domainDescHostname := domainFields[0].Descriptor()
+	// domain.HostnameValidator is a validator for the "hostname" field. It is called by the builders before save.
+	domain.HostnameValidator = domainDescHostname.Validators[0].(func(string) error)
+	// domainDescToken is the schema descriptor for token field.
+	domainDescToken := domainFields[2].Descriptor()
+	// domain.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	domain.TokenValidator = domainDescToken.Validators[0].(func(string) error)
+	// domainDescVerified is the schema descriptor for verified field.
+	domainDescVerified := domainFields[3].Descriptor()
+	// domain.DefaultVerified holds the default value on creation for the verified field.
+	domain.DefaultVerified = domainDescVerified.Default.(bool)
+	// domainDescCreatedAt is the schema descriptor for created_at field.
+	domainDescCreatedAt := domainFields[5].Descriptor()
+	// domain.DefaultCreatedAt holds the default value on creation for the created_at field.
+	domain.DefaultCreatedAt = domainDescCreatedAt.Default.(func() time.Time)
+	envvarFields := schema.EnvVar{}.Fields()
+	_ = envvarFields
+	// envvarDescKey is the schema descriptor for key field.
+	envvarDescKey := envvarFields[0].Descriptor()
+	// envvar.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	envvar.KeyValidator = envvarDescKey.Validators[0].(func(string) error)
+	// envvarDescCreatedAt is the schema descriptor for created_at field.
+	envvarDescCreatedAt := envvarFields[3].Descriptor()
+	// envvar.DefaultCreatedAt holds the default value on creation for the created_at field.
+	envvar.DefaultCreatedAt = envvarDescCreatedAt.Default.(func() time.Time)
+	// envvarDescUpdatedAt is the schema descriptor for updated_at field.
+	envvarDescUpdatedAt := envvarFields[4].Descriptor()
+	// envvar.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	envvar.DefaultUpdatedAt = envvarDescUpdatedAt.Default.(func() time.Time)
+	// envvar.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	envvar.UpdateDefaultUpdatedAt = envvarDescUpdatedAt.UpdateDefault.(func() time.Time)
+	projectFields := schema.Project{}.Fields()
+	_ = projectFields
+	// projectDescName is the schema descriptor for name field.
+	projectDescName := projectFields[0].Descriptor()
+	// project.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	project.NameValidator = projectDescName.Validators[0].(func(string) error)
+	// projectDescOwner is the schema descriptor for owner field.
+	projectDescOwner := projectFields[1].Descriptor()
+	// project.OwnerValidator is a validator for the "owner" field. It is called by the builders before save.
+	project.OwnerValidator = projectDescOwner.Validators[0].(func(string) error)
+	// projectDescRepo is the schema descriptor for repo field.
+	projectDescRepo := projectFields[2].Descriptor()
+	// project.RepoValidator is a validator for the "repo" field. It is called by the builders before save.
+	project.RepoValidator = projectDescRepo.Validators[0].(func(string) error)
+	// projectDescForkPreviews is the schema descriptor for fork_previews field.
+	projectDescForkPreviews := projectFields[9].Descriptor()
+	// project.DefaultForkPreviews holds the default value on creation for the fork_previews field.
+	project.DefaultForkPreviews = projectDescForkPreviews.Default.(bool)
+	// projectDescCreatedAt is the schema descriptor for created_at field.
+	projectDescCreatedAt := projectFields[10].Descriptor()
+	// project.DefaultCreatedAt holds the default value on creation for the created_at field.
+	project.DefaultCreatedAt = projectDescCreatedAt.Default.(func() time.Time)
+	// projectDescUpdatedAt is the schema descriptor for updated_at field.
+	projectDescUpdatedAt := projectFields[11].Descriptor()
+	// project.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	project.DefaultUpdatedAt = projectDescUpdatedAt.Default.(func() time.Time)
+	// project.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	project.UpdateDefaultUpdatedAt = projectDescUpdatedAt.UpdateDefault.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescUsername is the schema descriptor for username field.
@@ -38,5 +130,19 @@ func init() {
 	// userDescPassword is the schema descriptor for password field.
 	userDescPassword := userFields[2].Descriptor()
 	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
-	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	user.PasswordValidator = func() func(string) error {
+		validators := userDescPassword.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(password string) error {
+			for _, fn := range fns {
+				if err := fn(password); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 }
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Deployment holds the schema definition for the Deployment entity.
+type Deployment struct {
+	ent.Schema
+}
+
+// Fields of the Deployment.
+func (Deployment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable(),
+		field.String("owner").NotEmpty(),
+		field.String("repo").NotEmpty(),
+		field.String("ref").Optional(),
+		field.String("environment").Default("production"),
+		field.Int("pr_number").Optional(),
+		field.String("commit_sha").Optional(),
+		field.String("project_type").Optional(),
+		field.String("build_dir").Optional(),
+		field.String("cache_status").Optional(),
+		field.String("url").Optional(),
+		field.String("status"),
+		field.String("error").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Deployment.
+func (Deployment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).Ref("deployments").Unique(),
+		edge.From("user", User.Type).Ref("deployments").Unique(),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Domain holds the schema definition for the Domain entity, a custom
+// hostname attached to a project. It is only routed once verified. Several
+// projects may claim a hostname, but only one of them can verify it.
+type Domain struct {
+	ent.Schema
+}
+
+// Fields of the Domain.
+func (Domain) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("hostname").NotEmpty(),
+		field.Enum("method").Values("dns", "http").Default("dns"),
+		field.String("token").NotEmpty().Sensitive(),
+		field.Bool("verified").Default(false),
+		field.Time("verified_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Domain.
+func (Domain) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).Ref("domains").Unique().Required(),
+	}
+}
+
+// Indexes of the Domain.
+func (Domain) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hostname").Edges("project").Unique(),
+		index.Fields("hostname").Unique().Annotations(entsql.IndexWhere("verified")),
+	}
+}
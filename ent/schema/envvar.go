@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EnvVar holds the schema definition for the EnvVar entity. Values are
+// encrypted before they are stored.
+type EnvVar struct {
+	ent.Schema
+}
+
+// Fields of the EnvVar.
+func (EnvVar) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").NotEmpty(),
+		field.Bytes("value").Sensitive(),
+		field.Enum("environment").Values("production", "preview").Default("production"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the EnvVar.
+func (EnvVar) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).Ref("env_vars").Unique().Required(),
+	}
+}
+
+// Indexes of the EnvVar.
+func (EnvVar) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key", "environment").Edges("project").Unique(),
+	}
+}
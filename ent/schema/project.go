@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/RajBhut/go-basics/model"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Project holds the schema definition for the Project entity.
+type Project struct {
+	ent.Schema
+}
+
+// Fields of the Project.
+func (Project) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique().NotEmpty(),
+		field.String("owner").NotEmpty(),
+		field.String("repo").NotEmpty(),
+		field.String("branch").Optional(),
+		field.String("project_type").Optional(),
+		field.String("build_dir").Optional(),
+		field.String("url").Optional(),
+		field.String("current_release").Optional(),
+		field.JSON("health_check", &model.HealthCheck{}).Optional(),
+		// ForkPreviews builds previews of pull requests from forks, which run
+		// untrusted code
+		field.Bool("fork_previews").Default(false),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Project.
+func (Project) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deployments", Deployment.Type),
+		edge.To("env_vars", EnvVar.Type),
+		edge.To("domains", Domain.Type),
+		edge.From("user", User.Type).Ref("projects").Unique(),
+	}
+}
@@ -12,6 +12,14 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Deployment is the client for interacting with the Deployment builders.
+	Deployment *DeploymentClient
+	// Domain is the client for interacting with the Domain builders.
+	Domain *DomainClient
+	// EnvVar is the client for interacting with the EnvVar builders.
+	EnvVar *EnvVarClient
+	// Project is the client for interacting with the Project builders.
+	Project *ProjectClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
 	// User is the client for interacting with the User builders.
@@ -147,6 +155,10 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Deployment = NewDeploymentClient(tx.config)
+	tx.Domain = NewDomainClient(tx.config)
+	tx.EnvVar = NewEnvVarClient(tx.config)
+	tx.Project = NewProjectClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
@@ -158,7 +170,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Task.QueryXXX(), the query will be executed
+// applies a query, for example: Deployment.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
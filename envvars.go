@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/RajBhut/go-basics/ent"
+	"github.com/RajBhut/go-basics/ent/deployment"
+	"github.com/RajBhut/go-basics/ent/envvar"
+	"github.com/RajBhut/go-basics/ent/project"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-github/github"
+	"golang.org/x/oauth2"
+)
+
+var envKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// envCipher builds the AES-GCM cipher used for env var values from the
+// base64 encoded 32 byte key in HOSTER_SECRET_KEY
+func envCipher() (cipher.AEAD, error) {
+	key, err := base64.StdEncoding.DecodeString(os.Getenv("HOSTER_SECRET_KEY"))
+	if err != nil || len(key) != 32 {
+		return nil, errors.New("HOSTER_SECRET_KEY must be a base64 encoded 32 byte key")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func encryptEnvValue(plaintext string) ([]byte, error) {
+	gcm, err := envCipher()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, []byte(plaintext), nil), nil
+}
+
+func decryptEnvValue(ciphertext []byte) (string, error) {
+	gcm, err := envCipher()
+	if err != nil {
+		return "", err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return "", errors.New("stored value is corrupt")
+	}
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return "", errors.New("stored value could not be decrypted")
+	}
+	return string(plaintext), nil
+}
+
+// projectEnv returns a project's decrypted variables for one environment
+// as KEY=value pairs, sorted by key
+func projectEnv(projectName, environment string) ([]string, error) {
+	if db == nil {
+		return nil, nil
+	}
+
+	vars, err := db.EnvVar.Query().
+		Where(
+			envvar.HasProjectWith(project.Name(projectName)),
+			envvar.EnvironmentEQ(envvar.Environment(environment)),
+		).
+		Order(ent.Asc(envvar.FieldKey)).
+		All(dbCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	var env []string
+	for _, v := range vars {
+		value, err := decryptEnvValue(v.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", v.Key, err)
+		}
+		env = append(env, v.Key+"="+value)
+	}
+	return env, nil
+}
+
+// requireProjectOwner loads the project in the URL and checks that the
+// logged in GitHub user owns it
+func requireProjectOwner(c *gin.Context) (*ent.Project, bool) {
+	return requireOwner(c, c.Param("name"))
+}
+
+// requireDeploymentOwner checks that the logged in GitHub user owns the
+// project of the deployment in the URL
+func requireDeploymentOwner(c *gin.Context) bool {
+	if _, err := c.Cookie("access_token"); err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return false
+	}
+
+	name := ""
+	if job, ok := deployQueue.get(c.Param("id")); ok {
+		name = job.Project
+	} else if p, err := db.Deployment.Query().Where(deployment.ID(c.Param("id"))).QueryProject().Only(dbCtx); err == nil {
+		name = p.Name
+	}
+	if name == "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "deployment not found"})
+		return false
+	}
+	_, ok := requireOwner(c, name)
+	return ok
+}
+
+// requireOwner loads the named project and checks that the logged in
+// GitHub user owns it
+func requireOwner(c *gin.Context, name string) (*ent.Project, bool) {
+	if _, err := c.Cookie("access_token"); err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return nil, false
+	}
+
+	p, err := db.Project.Query().Where(project.Name(name)).Only(dbCtx)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "project not found"})
+		return nil, false
+	}
+
+	login, ok := requireLogin(c)
+	if !ok {
+		return nil, false
+	}
+	if login != p.Owner {
+		c.JSON(http.StatusForbidden, gin.H{"error": "you do not own this project"})
+		return nil, false
+	}
+	return p, true
+}
+
+// requireLogin returns the GitHub login of the logged in user
+func requireLogin(c *gin.Context) (string, bool) {
+	tokenCookie, err := c.Cookie("access_token")
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return "", false
+	}
+
+	token := &oauth2.Token{AccessToken: tokenCookie}
+	client := github.NewClient(githubOauthConfig.Client(context.Background(), token))
+	user, _, err := client.Users.Get(context.Background(), "")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not fetch user info"})
+		return "", false
+	}
+	return user.GetLogin(), true
+}
+
+func envEnvironment(c *gin.Context) (string, bool) {
+	environment := c.DefaultQuery("environment", environmentProduction)
+	if err := envvar.EnvironmentValidator(envvar.Environment(environment)); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "environment must be production or preview"})
+		return "", false
+	}
+	return environment, true
+}
+
+// Lists a project's variables for one environment
+func listEnvHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+	environment, ok := envEnvironment(c)
+	if !ok {
+		return
+	}
+
+	env, err := projectEnv(p.Name, environment)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	vars := gin.H{}
+	for _, kv := range env {
+		key, value, _ := strings.Cut(kv, "=")
+		vars[key] = value
+	}
+	c.JSON(http.StatusOK, gin.H{"environment": environment, "env": vars})
+}
+
+// Creates or replaces variables. The body is a KEY: value object.
+func setEnvHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+	environment, ok := envEnvironment(c)
+	if !ok {
+		return
+	}
+
+	var vars map[string]string
+	if err := c.ShouldBindJSON(&vars); err != nil || len(vars) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		if !envKeyPattern.MatchString(key) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid variable name %q", key)})
+			return
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value, err := encryptEnvValue(vars[key])
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		existing, err := p.QueryEnvVars().
+			Where(envvar.Key(key), envvar.EnvironmentEQ(envvar.Environment(environment))).
+			Only(dbCtx)
+		if err == nil {
+			err = existing.Update().SetValue(value).Exec(dbCtx)
+		} else if ent.IsNotFound(err) {
+			err = db.EnvVar.Create().
+				SetKey(key).
+				SetValue(value).
+				SetEnvironment(envvar.Environment(environment)).
+				SetProject(p).
+				Exec(dbCtx)
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "could not save variable"})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Variables saved", "environment": environment, "keys": keys})
+}
+
+// Removes a single variable
+func deleteEnvHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+	environment, ok := envEnvironment(c)
+	if !ok {
+		return
+	}
+
+	deleted, err := db.EnvVar.Delete().
+		Where(
+			envvar.HasProjectWith(project.ID(p.ID)),
+			envvar.Key(c.Param("key")),
+			envvar.EnvironmentEQ(envvar.Environment(environment)),
+		).
+		Exec(dbCtx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not delete variable"})
+		return
+	}
+	if deleted == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "variable not found"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Variable deleted"})
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// Preset is how a detected framework is built and served. The manifest can
+// override any part of it.
+type Preset struct {
+	Framework string
+	// Type is "node" for frameworks built with a package manager and
+	// "static" for everything else that produces a static site
+	Type string
+	// Toolchain selects the build image for container builds
+	Toolchain string
+	Install   []string
+	Build     []string
+	// Start is set for frameworks that need a server at runtime
+	Start []string
+	// Output is the build output directory, "." for the project itself
+	Output string
+	// PackageManager and NodeVersion are only set for Node projects,
+	// NodeVersion holds the engines.node range
+	PackageManager string
+	NodeVersion    string
+	// Env tells the framework which base path the site is served under
+	Env []string
+}
+
+// Detector recognizes a framework from the files in a project directory
+type Detector interface {
+	Name() string
+	Detect(p *projectFiles) (Preset, bool)
+}
+
+// detectors are tried in order, so more specific ones come first
+var detectors []Detector
+
+func registerDetector(d Detector) {
+	detectors = append(detectors, d)
+}
+
+// packageJSON holds the parts of package.json detection looks at
+type packageJSON struct {
+	Scripts         map[string]string `json:"scripts"`
+	Dependencies    map[string]string `json:"dependencies"`
+	DevDependencies map[string]string `json:"devDependencies"`
+	PackageManager  string            `json:"packageManager"`
+	Engines         map[string]string `json:"engines"`
+}
+
+// projectFiles gives detectors cached access to a project directory
+type projectFiles struct {
+	dir string
+	pkg *packageJSON
+}
+
+func newProjectFiles(dir string) *projectFiles {
+	p := &projectFiles{dir: dir}
+	if data, err := os.ReadFile(filepath.Join(dir, "package.json")); err == nil {
+		var pkg packageJSON
+		if json.Unmarshal(data, &pkg) == nil {
+			p.pkg = &pkg
+		}
+	}
+	return p
+}
+
+// hasFile reports whether any of names exists in the project
+func (p *projectFiles) hasFile(names ...string) bool {
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(p.dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDependency reports whether package.json depends on name
+func (p *projectFiles) hasDependency(name string) bool {
+	if p.pkg == nil {
+		return false
+	}
+	_, dep := p.pkg.Dependencies[name]
+	_, devDep := p.pkg.DevDependencies[name]
+	return dep || devDep
+}
+
+// fileMatches reports whether any of names exists and matches pattern
+func (p *projectFiles) fileMatches(names []string, pattern *regexp.Regexp) bool {
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(p.dir, name))
+		if err == nil && pattern.Match(data) {
+			return true
+		}
+	}
+	return false
+}
+
+// detectFramework returns the preset of the first detector that recognizes
+// the project in dir
+func detectFramework(dir string) (Preset, bool) {
+	p := newProjectFiles(dir)
+	for _, d := range detectors {
+		if preset, ok := d.Detect(p); ok {
+			preset.Framework = d.Name()
+			return preset, true
+		}
+	}
+	return Preset{}, false
+}
+
+// frameworkDetector is a Detector built from a match function and a preset
+type frameworkDetector struct {
+	name   string
+	match  func(p *projectFiles) bool
+	preset func(p *projectFiles) Preset
+}
+
+func (d frameworkDetector) Name() string { return d.name }
+
+func (d frameworkDetector) Detect(p *projectFiles) (Preset, bool) {
+	if !d.match(p) {
+		return Preset{}, false
+	}
+	return d.preset(p), true
+}
+
+// nodePreset builds with the project's build script into output
+func nodePreset(output string, env ...string) func(p *projectFiles) Preset {
+	return func(p *projectFiles) Preset {
+		pm := detectPackageManager(p)
+		preset := Preset{
+			Type:           "node",
+			Toolchain:      pm.Toolchain,
+			Install:        pm.Install,
+			Output:         output,
+			Env:            env,
+			PackageManager: pm.Name,
+		}
+		if p.pkg == nil || p.pkg.Scripts["build"] != "" {
+			preset.Build = pm.run("build")
+		}
+		if p.pkg != nil {
+			preset.NodeVersion = p.pkg.Engines["node"]
+		}
+		return preset
+	}
+}
+
+var (
+	nextConfigs      = []string{"next.config.js", "next.config.mjs", "next.config.ts"}
+	nextStaticExport = regexp.MustCompile(`output\s*:\s*["']export["']`)
+)
+
+func init() {
+	// Sites are served from the root of their own host, so base paths are /
+	registerDetector(frameworkDetector{
+		name: "Next.js (static export)",
+		match: func(p *projectFiles) bool {
+			return p.hasDependency("next") && p.fileMatches(nextConfigs, nextStaticExport)
+		},
+		preset: nodePreset("out"),
+	})
+	registerDetector(frameworkDetector{
+		name:  "Next.js",
+		match: func(p *projectFiles) bool { return p.hasDependency("next") },
+		preset: func(p *projectFiles) Preset {
+			preset := nodePreset("")(p)
+			preset.Start = detectPackageManager(p).run("start")
+			return preset
+		},
+	})
+	registerDetector(frameworkDetector{
+		name: "Astro",
+		match: func(p *projectFiles) bool {
+			return p.hasDependency("astro") || p.hasFile("astro.config.mjs", "astro.config.js", "astro.config.ts")
+		},
+		preset: nodePreset("dist"),
+	})
+	registerDetector(frameworkDetector{
+		name:  "SvelteKit",
+		match: func(p *projectFiles) bool { return p.hasDependency("@sveltejs/kit") },
+		// adapter-static writes to build/
+		preset: nodePreset("build"),
+	})
+	registerDetector(frameworkDetector{
+		name:   "Create React App",
+		match:  func(p *projectFiles) bool { return p.hasDependency("react-scripts") },
+		preset: nodePreset("build", "PUBLIC_URL=/"),
+	})
+	registerDetector(frameworkDetector{
+		name: "Vite",
+		match: func(p *projectFiles) bool {
+			return p.hasDependency("vite") || p.hasFile("vite.config.js", "vite.config.mjs", "vite.config.ts")
+		},
+		preset: nodePreset("dist"),
+	})
+	registerDetector(frameworkDetector{
+		name: "Hugo",
+		match: func(p *projectFiles) bool {
+			return p.hasFile("hugo.toml", "hugo.yaml", "hugo.json") ||
+				(p.hasFile("config.toml") && p.hasFile("content", "layouts", "themes"))
+		},
+		preset: func(p *projectFiles) Preset {
+			return Preset{
+				Type:      "static",
+				Toolchain: "hugo",
+				Build:     []string{"hugo", "--minify", "--baseURL", "/"},
+				Output:    "public",
+			}
+		},
+	})
+	registerDetector(frameworkDetector{
+		name:  "Jekyll",
+		match: func(p *projectFiles) bool { return p.hasFile("_config.yml", "_config.yaml") },
+		preset: func(p *projectFiles) Preset {
+			preset := Preset{
+				Type:      "static",
+				Toolchain: "ruby",
+				Build:     []string{"jekyll", "build", "--baseurl", ""},
+				Output:    "_site",
+				Env:       []string{"JEKYLL_ENV=production"},
+			}
+			if p.hasFile("Gemfile") {
+				preset.Install = []string{"bundle", "install"}
+				preset.Build = append([]string{"bundle", "exec"}, preset.Build...)
+			}
+			return preset
+		},
+	})
+	registerDetector(frameworkDetector{
+		name:   "Node.js",
+		match:  func(p *projectFiles) bool { return p.hasFile("package.json") },
+		preset: nodePreset(""),
+	})
+	registerDetector(frameworkDetector{
+		name:  "Static HTML",
+		match: func(p *projectFiles) bool { return p.hasFile("index.html") },
+		preset: func(p *projectFiles) Preset {
+			return Preset{Type: "static", Output: "."}
+		},
+	})
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"fmt"
+	"go/build"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// GoOptions are the go section of the manifest
+type GoOptions struct {
+	// Main is the main package to build, e.g. ./cmd/server. It is required
+	// when the module has more than one.
+	Main    string   `json:"main" yaml:"main"`
+	LDFlags string   `json:"ldflags" yaml:"ldflags"`
+	Tags    []string `json:"tags" yaml:"tags"`
+	// CGO is off by default so binaries don't depend on the host's libc
+	CGO bool `json:"cgo" yaml:"cgo"`
+}
+
+var goTagPattern = regexp.MustCompile(`^[A-Za-z0-9_.]+$`)
+
+// validate returns the problems with the go section of a manifest whose
+// project lives in projectDir
+func (o *GoOptions) validate(projectDir string) []string {
+	var errs []string
+	if o.Main != "" {
+		main := filepath.Clean(o.Main)
+		if filepath.IsAbs(main) || main == ".." || strings.HasPrefix(main, ".."+string(filepath.Separator)) {
+			errs = append(errs, "go.main must be a package inside the project")
+		} else if info, err := os.Stat(filepath.Join(projectDir, main)); err != nil || !info.IsDir() {
+			errs = append(errs, fmt.Sprintf("go.main %q does not exist", o.Main))
+		}
+	}
+	for _, tag := range o.Tags {
+		if !goTagPattern.MatchString(tag) {
+			errs = append(errs, fmt.Sprintf("invalid build tag %q", tag))
+		}
+	}
+	return errs
+}
+
+// findMainPackages lists the main packages of the module in dir as
+// ./relative import paths. Directories the go tool ignores and nested
+// modules are skipped.
+func findMainPackages(dir string) ([]string, error) {
+	var packages []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != dir {
+			name := d.Name()
+			if name == "vendor" || name == "testdata" || name == "node_modules" ||
+				strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil {
+				return filepath.SkipDir
+			}
+		}
+
+		// ImportDir applies build constraints, so `//go:build ignore`
+		// generators don't count
+		pkg, err := build.ImportDir(path, 0)
+		if err != nil || pkg.Name != "main" {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return nil
+		}
+		if rel == "." {
+			packages = append(packages, ".")
+		} else {
+			packages = append(packages, "./"+filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	sort.Strings(packages)
+	return packages, err
+}
+
+// selectMainPackage picks the package to build, the manifest's go.main if
+// set, otherwise the only main package of the module
+func selectMainPackage(dir string, options *GoOptions) (string, error) {
+	packages, err := findMainPackages(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to search for main packages: %v", err)
+	}
+
+	if options != nil && options.Main != "" {
+		main := filepath.ToSlash(filepath.Clean(options.Main))
+		if main != "." {
+			main = "./" + main
+		}
+		for _, pkg := range packages {
+			if pkg == main {
+				return pkg, nil
+			}
+		}
+		return "", fmt.Errorf("go.main %q is not a main package", options.Main)
+	}
+
+	switch len(packages) {
+	case 0:
+		return "", fmt.Errorf("no main package found")
+	case 1:
+		return packages[0], nil
+	}
+	return "", fmt.Errorf("found several main packages (%s), set go.main in hoster.json to pick one", strings.Join(packages, ", "))
+}
+
+// binaryName names the binary built from pkg
+func binaryName(pkg string) string {
+	if pkg == "." {
+		return "app"
+	}
+	return filepath.Base(pkg)
+}
+
+// artifactDir is where a deployment's binaries are built, outside the
+// workspace so the source tree stays untouched
+func artifactDir(site, deploymentID string) string {
+	return filepath.Join(artifactsDir, site, deploymentID)
+}
+
+// goBuildArgs returns the go build command for pkg writing to output
+func goBuildArgs(pkg, output string, options *GoOptions) []string {
+	args := []string{"go", "build", "-trimpath", "-o", output}
+	if options != nil {
+		if options.LDFlags != "" {
+			args = append(args, "-ldflags="+options.LDFlags)
+		}
+		if len(options.Tags) > 0 {
+			args = append(args, "-tags="+strings.Join(options.Tags, ","))
+		}
+	}
+	return append(args, pkg)
+}
+
+// goModCache returns the module cache of a Go build. Go trusts modules
+// already in GOMODCACHE without checking go.sum, so every project gets its
+// own and builds of forks start from an empty one in their workspace.
+func goModCache(job *deployJob, repoDir string) (string, error) {
+	dir := filepath.Join(goModCacheDir, projectCacheID(job.Owner, job.Project))
+	if job.Fork {
+		dir = filepath.Join(repoDir, workspaceCacheDir, "gomod")
+	}
+	return filepath.Abs(dir)
+}
+
+// goBuildEnv points the build at modCache and sets cgo. Modules are kept
+// writable so the cache can be removed with the project or workspace, on
+// top of any GOFLAGS in env.
+func goBuildEnv(env []string, modCache string, options *GoOptions) []string {
+	cgo := "0"
+	if options != nil && options.CGO {
+		cgo = "1"
+	}
+	flags := "-modcacherw"
+	for _, kv := range env {
+		if value, ok := strings.CutPrefix(kv, "GOFLAGS="); ok && value != "" {
+			flags = value + " -modcacherw"
+		}
+	}
+	return []string{"GOMODCACHE=" + modCache, "CGO_ENABLED=" + cgo, "GOFLAGS=" + flags}
+}
+
+// pruneArtifacts keeps the binaries of the newest releaseRetention
+// deployments of a site, and always those of its running instances
+func pruneArtifacts(site string) {
+	dir := filepath.Join(artifactsDir, site)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	type artifact struct {
+		name    string
+		modTime int64
+	}
+	var all []artifact
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		all = append(all, artifact{entry.Name(), info.ModTime().UnixNano()})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].modTime > all[j].modTime })
+
+	kept := map[string]bool{}
+	for _, slot := range []string{slotBlue, slotGreen} {
+		if spec, ok := apps.spec(slotInstance(site, slot)); ok && len(spec.Args) > 0 {
+			kept[filepath.Base(filepath.Dir(spec.Args[0]))] = true
+		}
+	}
+	for i, a := range all {
+		if i < releaseRetention || kept[a.name] {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(dir, a.name)); err != nil {
+			fmt.Printf("Warning: Failed to remove artifact %s/%s: %v\n", site, a.name, err)
+		}
+	}
+}
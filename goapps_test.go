@@ -0,0 +1,49 @@
+package main
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGoModCacheIsPerProject(t *testing.T) {
+	repo := t.TempDir()
+	cache := func(owner, project string, fork bool) string {
+		job := &deployJob{Owner: owner, Project: project, Fork: fork}
+		dir, err := goModCache(job, repo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dir
+	}
+
+	site := cache("octo-org", "site", false)
+	if site != cache("octo-org", "site", false) {
+		t.Error("a project's builds use different module caches")
+	}
+	for _, other := range []string{cache("octo-org", "api", false), cache("mallory", "site", false)} {
+		if other == site {
+			t.Errorf("module cache %s is shared between projects", site)
+		}
+	}
+	shared, _ := filepath.Abs(goModCacheDir)
+	if fork := cache("octo-org", "site", true); !strings.HasPrefix(fork, repo) || strings.HasPrefix(fork, shared) {
+		t.Errorf("fork builds use %s, want a cache in the workspace", fork)
+	}
+}
+
+func TestGoBuildEnvKeepsGOFLAGS(t *testing.T) {
+	tests := []struct {
+		env  []string
+		want string
+	}{
+		{nil, "GOFLAGS=-modcacherw"},
+		{[]string{"GOFLAGS=-tags=prod"}, "GOFLAGS=-tags=prod -modcacherw"},
+	}
+	for _, tt := range tests {
+		if env := goBuildEnv(tt.env, "/cache", nil); !slices.Contains(env, tt.want) {
+			t.Errorf("goBuildEnv(%v) = %v, want %s", tt.env, env, tt.want)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/RajBhut/go-basics/ent/project"
+	"github.com/RajBhut/go-basics/model"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultReadyTimeout     = 30
+	defaultProbeInterval    = 10
+	defaultFailureThreshold = 3
+	// probeTimeout bounds a single probe request
+	probeTimeout = 5 * time.Second
+	// readyPollInterval is how often a new instance is probed while it
+	// starts up
+	readyPollInterval = 500 * time.Millisecond
+)
+
+// healthCheckWithDefaults fills in whatever the project left unset. Without
+// any configuration an app only has to accept TCP connections on its port.
+func healthCheckWithDefaults(check *model.HealthCheck) model.HealthCheck {
+	result := model.HealthCheck{Type: "tcp"}
+	if check != nil {
+		result = *check
+	}
+	if result.Type == "" {
+		result.Type = "http"
+	}
+	if result.Type == "http" && result.Path == "" {
+		result.Path = "/"
+	}
+	if result.Timeout <= 0 {
+		result.Timeout = defaultReadyTimeout
+	}
+	if result.Interval <= 0 {
+		result.Interval = defaultProbeInterval
+	}
+	if result.FailureThreshold <= 0 {
+		result.FailureThreshold = defaultFailureThreshold
+	}
+	return result
+}
+
+func validateHealthCheck(check model.HealthCheck) error {
+	switch check.Type {
+	case "", "http":
+		if check.Path != "" && !strings.HasPrefix(check.Path, "/") {
+			return errors.New("path must start with /")
+		}
+		if check.ExpectedStatus != 0 && (check.ExpectedStatus < 100 || check.ExpectedStatus > 599) {
+			return errors.New("expected_status must be a valid HTTP status")
+		}
+	case "tcp":
+		if check.Path != "" || check.ExpectedStatus != 0 {
+			return errors.New("path and expected_status only apply to http checks")
+		}
+	default:
+		return errors.New("type must be http or tcp")
+	}
+	if check.Timeout < 0 || check.Interval < 0 || check.FailureThreshold < 0 {
+		return errors.New("timeout, interval and failure_threshold must not be negative")
+	}
+	return nil
+}
+
+// probe runs the check once against an app listening on port
+func probe(check model.HealthCheck, port int) error {
+	addr := fmt.Sprintf("localhost:%d", port)
+	if check.Type == "tcp" {
+		conn, err := net.DialTimeout("tcp", addr, probeTimeout)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+
+	client := &http.Client{
+		Timeout: probeTimeout,
+		// A redirect is an answer, following it could leave the app
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get("http://" + addr + check.Path)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if check.ExpectedStatus != 0 {
+		if resp.StatusCode != check.ExpectedStatus {
+			return fmt.Errorf("GET %s returned %d, expected %d", check.Path, resp.StatusCode, check.ExpectedStatus)
+		}
+	} else if resp.StatusCode >= 400 {
+		return fmt.Errorf("GET %s returned %d", check.Path, resp.StatusCode)
+	}
+	return nil
+}
+
+// waitReady probes a freshly started app until the check passes or the
+// check's timeout runs out
+func waitReady(check model.HealthCheck, port int) error {
+	deadline := time.Now().Add(time.Duration(check.Timeout) * time.Second)
+	for {
+		err := probe(check, port)
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("not ready after %ds: %v", check.Timeout, err)
+		}
+		time.Sleep(readyPollInterval)
+	}
+}
+
+// projectHealthCheck returns the check configured for a project, nil if
+// there is none
+func projectHealthCheck(projectName string) *model.HealthCheck {
+	if db == nil {
+		return nil
+	}
+	p, err := db.Project.Query().Where(project.Name(projectName)).Only(dbCtx)
+	if err != nil {
+		return nil
+	}
+	return p.HealthCheck
+}
+
+// Returns a project's health check with defaults filled in
+func getHealthCheckHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"configured":   p.HealthCheck != nil,
+		"health_check": healthCheckWithDefaults(p.HealthCheck),
+	})
+}
+
+// Replaces a project's health check. It applies from the next deployment.
+func setHealthCheckHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+
+	var check model.HealthCheck
+	if err := c.ShouldBindJSON(&check); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	if err := validateHealthCheck(check); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := p.Update().SetHealthCheck(&check).Exec(dbCtx); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not save health check"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Health check saved", "health_check": healthCheckWithDefaults(&check)})
+}
@@ -0,0 +1,306 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Deployment states reported by GET /deployments/:id
+const (
+	statusQueued     = "queued"
+	statusCloning    = "cloning"
+	statusInstalling = "installing"
+	statusBuilding   = "building"
+	statusPublishing = "publishing"
+	statusLive       = "live"
+	statusFailed     = "failed"
+)
+
+// Environments a deployment can target
+const (
+	environmentProduction = "production"
+	environmentPreview    = "preview"
+)
+
+var errQueueFull = errors.New("deployment queue is full, try again later")
+
+// deployJob tracks a single deployment as it moves through the pipeline
+type deployJob struct {
+	ID      string
+	Owner   string
+	Repo    string
+	Project string
+	Ref     string
+	Token   string
+	log     *deployLog
+
+	// Environment is production unless the job builds a pull request
+	// preview, in which case PRNumber is set
+	Environment string
+	PRNumber    int
+	// Fork is set for previews of pull requests from another repository.
+	// Their code is untrusted, so it gets neither the project's variables
+	// nor the shared build cache.
+	Fork bool
+
+	// env holds the project's variables for the job's environment, loaded
+	// when the job starts
+	env []string
+	// manifest is the repository's hoster.json / hoster.yaml, empty when
+	// it has none
+	manifest *Manifest
+	// preset is the detected framework's build preset, if any
+	preset Preset
+	// nodeVersion is the installed Node.js version matching engines.node
+	nodeVersion string
+	// appVars are added to the environment of the app the job starts
+	appVars []string
+	// mounts are directories outside the workspace build steps may write to
+	mounts []string
+
+	mu          sync.RWMutex
+	status      string
+	url         string
+	err         string
+	projectType string
+	buildDir    string
+	commitSHA   string
+	cacheStatus string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+func newDeployJob(owner, repo, ref, token string) *deployJob {
+	now := time.Now()
+	id := fmt.Sprintf("%s-%d", repo, now.UnixNano())
+	return &deployJob{
+		ID:          id,
+		Owner:       owner,
+		Repo:        repo,
+		Project:     repo,
+		Ref:         ref,
+		Token:       token,
+		log:         newDeployLog(id),
+		Environment: environmentProduction,
+		status:      statusQueued,
+		createdAt:   now,
+		updatedAt:   now,
+	}
+}
+
+func (j *deployJob) setStatus(status string) {
+	j.mu.Lock()
+	j.status = status
+	j.updatedAt = time.Now()
+	j.mu.Unlock()
+	j.log.Printf("==> Deployment %s: %s\n", j.ID, status)
+	updateDeploymentRecord(j)
+}
+
+func (j *deployJob) setProjectType(projectType string) {
+	j.mu.Lock()
+	j.projectType = projectType
+	j.mu.Unlock()
+}
+
+func (j *deployJob) setCommitSHA(sha string) {
+	j.mu.Lock()
+	j.commitSHA = sha
+	j.mu.Unlock()
+}
+
+func (j *deployJob) setBuildDir(buildDir string) {
+	j.mu.Lock()
+	j.buildDir = buildDir
+	j.mu.Unlock()
+}
+
+func (j *deployJob) setCacheStatus(status string) {
+	j.mu.Lock()
+	j.cacheStatus = status
+	j.mu.Unlock()
+}
+
+// finish marks the job as live or failed depending on err
+func (j *deployJob) finish(url string, err error) {
+	j.mu.Lock()
+	if err != nil {
+		j.status = statusFailed
+		j.err = err.Error()
+	} else {
+		j.status = statusLive
+		j.url = url
+	}
+	j.updatedAt = time.Now()
+	status := j.status
+	// The token is only needed to clone
+	j.Token = ""
+	j.mu.Unlock()
+	if err != nil {
+		j.log.Printf("Error: %v\n", err)
+	}
+	j.log.Printf("==> Deployment %s: %s\n", j.ID, status)
+	j.log.Close()
+	updateDeploymentRecord(j)
+}
+
+// siteName is the name the deployment is published and served under
+func (j *deployJob) siteName() string {
+	if j.Environment == environmentPreview {
+		return previewName(j.Project, j.PRNumber)
+	}
+	return j.Project
+}
+
+// build runs an untrusted build step through the configured Builder with
+// its output captured in the deployment log
+func (j *deployJob) build(dir, toolchain string, args ...string) error {
+	spec := BuildSpec{Dir: dir, Args: args, Env: j.env, Toolchain: toolchain, Mounts: j.mounts}
+	if j.manifest != nil {
+		spec.Runtime = j.manifest.Runtime
+	}
+	if spec.Runtime == "" && toolchain == "node" {
+		spec.Runtime = j.nodeVersion
+	}
+	return builder.Run(context.Background(), spec, j.log)
+}
+
+// buildStep runs command from the manifest through the shell, or the
+// default args when the manifest leaves the step out. A step without either
+// is skipped.
+func (j *deployJob) buildStep(dir, toolchain, command string, args ...string) error {
+	if command != "" {
+		j.log.Printf("$ %s\n", command)
+		return j.build(dir, toolchain, "sh", "-c", command)
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	j.log.Printf("$ %s\n", strings.Join(args, " "))
+	return j.build(dir, toolchain, args...)
+}
+
+// toolchain is the build image for the job's preset, node if it has none
+func (j *deployJob) toolchain() string {
+	if j.preset.Toolchain != "" {
+		return j.preset.Toolchain
+	}
+	return "node"
+}
+
+// run executes cmd with its stdout and stderr captured in the deployment log
+func (j *deployJob) run(cmd *exec.Cmd) error {
+	cmd.Stdout = j.log
+	cmd.Stderr = j.log
+	return cmd.Run()
+}
+
+func (j *deployJob) currentStatus() string {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.status
+}
+
+func (j *deployJob) snapshot() gin.H {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return gin.H{
+		"id":           j.ID,
+		"owner":        j.Owner,
+		"repo":         j.Repo,
+		"project":      j.Project,
+		"ref":          j.Ref,
+		"environment":  j.Environment,
+		"pr_number":    j.PRNumber,
+		"fork":         j.Fork,
+		"commit_sha":   j.commitSHA,
+		"project_type": j.projectType,
+		"build_dir":    j.buildDir,
+		"cache_status": j.cacheStatus,
+		"status":       j.status,
+		"deploy_url":   j.url,
+		"error":        j.err,
+		"created_at":   j.createdAt,
+		"updated_at":   j.updatedAt,
+	}
+}
+
+// jobQueue runs deployments on a fixed number of workers so that
+// concurrent deploys can't starve the server
+type jobQueue struct {
+	jobs chan *deployJob
+
+	mu   sync.RWMutex
+	byID map[string]*deployJob
+}
+
+func newJobQueue(workers, size int) *jobQueue {
+	q := &jobQueue{
+		jobs: make(chan *deployJob, size),
+		byID: make(map[string]*deployJob),
+	}
+	for i := 0; i < workers; i++ {
+		go q.worker()
+	}
+	return q
+}
+
+// enqueue registers the job and hands it to the workers without blocking
+func (q *jobQueue) enqueue(job *deployJob) error {
+	q.mu.Lock()
+	q.byID[job.ID] = job
+	q.mu.Unlock()
+
+	select {
+	case q.jobs <- job:
+		return nil
+	default:
+		q.remove(job.ID)
+		return errQueueFull
+	}
+}
+
+// remove forgets a finished job, which is then served from its Deployment
+// row and the log on disk
+func (q *jobQueue) remove(id string) {
+	q.mu.Lock()
+	delete(q.byID, id)
+	q.mu.Unlock()
+}
+
+func (q *jobQueue) get(id string) (*deployJob, bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	job, ok := q.byID[id]
+	return job, ok
+}
+
+// active reports whether a deployment of project is queued or running
+func (q *jobQueue) active(project string) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	for _, job := range q.byID {
+		if job.Project != project {
+			continue
+		}
+		if status := job.currentStatus(); status != statusLive && status != statusFailed {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *jobQueue) worker() {
+	for job := range q.jobs {
+		url, err := cloneAndDeployRepo(job)
+		job.finish(url, err)
+		q.remove(job.ID)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFinishedJobsLeaveTheQueue(t *testing.T) {
+	oldQueue, oldLogDir, oldBuilder := deployQueue, deploymentLogDir, builder
+	t.Cleanup(func() { deployQueue, deploymentLogDir, builder = oldQueue, oldLogDir, oldBuilder })
+	deploymentLogDir = t.TempDir()
+	builder = &hostBuilder{}
+	deployQueue = newJobQueue(1, 1)
+
+	// A fork fails before cloning without an isolated builder
+	job := newDeployJob("octo-org", "site", "main", "gho_secret")
+	job.Fork = true
+	_, _, ok := job.log.subscribe(0)
+	if !ok {
+		t.Fatal("subscribe to a new job's log failed")
+	}
+	if err := deployQueue.enqueue(job); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, ok := deployQueue.get(job.ID); !ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("finished job is still queued")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if job.currentStatus() != statusFailed {
+		t.Errorf("status = %s, want %s", job.currentStatus(), statusFailed)
+	}
+	if deployQueue.active(job.Project) {
+		t.Error("project still has an active deployment")
+	}
+	if job.Token != "" {
+		t.Error("finished job kept its token")
+	}
+	if _, _, ok := job.log.subscribe(0); ok || job.log.lines != nil {
+		t.Error("finished log is still kept in memory")
+	}
+	lines, err := readDeploymentLog(job.ID)
+	if err != nil || len(lines) == 0 {
+		t.Errorf("readDeploymentLog = %v, %v, want the log on disk", lines, err)
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// logSubscriberBuffer is how many lines a live reader may fall behind
+// before it is dropped
+var logSubscriberBuffer = 256
+
+// deployLog collects a deployment's output line by line, keeps a copy on
+// disk and fans new lines out to anyone following it live
+type deployLog struct {
+	mu      sync.Mutex
+	lines   []string
+	partial []byte
+	file    *os.File
+	subs    map[chan string]struct{}
+	closed  bool
+}
+
+func deploymentLogPath(id string) string {
+	return filepath.Join(deploymentLogDir, id+".log")
+}
+
+func newDeployLog(id string) *deployLog {
+	l := &deployLog{subs: make(map[chan string]struct{})}
+
+	file, err := os.Create(deploymentLogPath(id))
+	if err != nil {
+		fmt.Printf("Warning: Could not create log file for %s: %v\n", id, err)
+	} else {
+		l.file = file
+	}
+	return l
+}
+
+// Write lets the log be used directly as a command's Stdout/Stderr
+func (l *deployLog) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.partial = append(l.partial, p...)
+	for {
+		i := bytes.IndexByte(l.partial, '\n')
+		if i < 0 {
+			break
+		}
+		l.appendLine(string(bytes.TrimRight(l.partial[:i], "\r")))
+		l.partial = l.partial[i+1:]
+	}
+	return len(p), nil
+}
+
+func (l *deployLog) Printf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	fmt.Print(msg)
+	l.Write([]byte(msg))
+}
+
+// appendLine must be called with l.mu held
+func (l *deployLog) appendLine(line string) {
+	if l.closed {
+		return
+	}
+	l.lines = append(l.lines, line)
+	if l.file != nil {
+		fmt.Fprintln(l.file, line)
+	}
+	for ch := range l.subs {
+		select {
+		case ch <- line:
+		default:
+			// Slow reader, drop it rather than stall the build. It
+			// subscribes again from the last line it got.
+			delete(l.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// subscribe returns the lines logged so far, skipping the first from, and
+// a channel that receives every following line. The channel is closed once
+// the log is finished or the reader falls behind. Finished logs are only
+// kept on disk, so subscribing to one returns false.
+func (l *deployLog) subscribe(from int) ([]string, chan string, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return nil, nil, false
+	}
+	backlog := append([]string(nil), l.lines[min(from, len(l.lines)):]...)
+	ch := make(chan string, logSubscriberBuffer)
+	l.subs[ch] = struct{}{}
+	return backlog, ch, true
+}
+
+func (l *deployLog) unsubscribe(ch chan string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.subs[ch]; ok {
+		delete(l.subs, ch)
+		close(ch)
+	}
+}
+
+// Close flushes any unterminated line and ends all live subscriptions
+func (l *deployLog) Close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.partial) > 0 {
+		l.appendLine(string(l.partial))
+		l.partial = nil
+	}
+	l.closed = true
+	l.lines = nil
+	for ch := range l.subs {
+		delete(l.subs, ch)
+		close(ch)
+	}
+	if l.file != nil {
+		l.file.Close()
+	}
+}
+
+// readDeploymentLog loads the stored log of a deployment that is no longer
+// tracked in memory
+func readDeploymentLog(id string) ([]string, error) {
+	file, err := os.Open(deploymentLogPath(id))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
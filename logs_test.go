@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// readEvents collects the Server-Sent Events of a response as
+// "event: data" strings
+func readEvents(t *testing.T, resp *http.Response) []string {
+	t.Helper()
+	var events []string
+	event := ""
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if name, ok := strings.CutPrefix(line, "event:"); ok {
+			event = name
+		} else if data, ok := strings.CutPrefix(line, "data:"); ok {
+			events = append(events, event+": "+data)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return events
+}
+
+func TestDeploymentLogsResumeAfterLagging(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	oldQueue, oldLogDir, oldBuffer := deployQueue, deploymentLogDir, logSubscriberBuffer
+	t.Cleanup(func() { deployQueue, deploymentLogDir, logSubscriberBuffer = oldQueue, oldLogDir, oldBuffer })
+	deploymentLogDir = t.TempDir()
+	deployQueue = newJobQueue(0, 16)
+	// Every reader falls behind on the first burst of output
+	logSubscriberBuffer = 1
+
+	job := newDeployJob("octo-org", "site", "main", "")
+	if err := deployQueue.enqueue(job); err != nil {
+		t.Fatal(err)
+	}
+	job.log.Printf("Cloning repository\n")
+
+	r := gin.New()
+	r.GET("/deployments/:id/logs", func(c *gin.Context) { streamDeploymentLogs(c, c.Param("id")) })
+	server := httptest.NewServer(r)
+	defer server.Close()
+	resp, err := http.Get(server.URL + "/deployments/" + job.ID + "/logs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	for i := 0; i < 2000; i++ {
+		fmt.Fprintf(job.log, "line %d\n", i)
+	}
+	job.finish("", errors.New("build failed"))
+	events := readEvents(t, resp)
+
+	lines, err := readDeploymentLog(job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []string
+	for _, line := range lines {
+		want = append(want, "log: "+line)
+	}
+	want = append(want, "end: "+statusFailed)
+	if !slices.Equal(events, want) {
+		t.Errorf("got %d events ending in %q, want every one of the %d lines once and then the end", len(events), events[len(events)-1], len(lines))
+	}
+}
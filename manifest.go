@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/RajBhut/go-basics/model"
+	"gopkg.in/yaml.v3"
+)
+
+// manifestFiles are the names a manifest may have at the repository root
+var manifestFiles = []string{"hoster.json", "hoster.yaml", "hoster.yml"}
+
+// Manifest is the optional hoster.json / hoster.yaml at the root of a
+// repository. Every field is optional, whatever is left out is detected.
+type Manifest struct {
+	// Root is the project directory relative to the repository root
+	Root string `json:"root" yaml:"root"`
+	// Type is one of node, go, python, static or docker
+	Type string `json:"type" yaml:"type"`
+	// Runtime is the toolchain version, e.g. "20" for Node.js 20
+	Runtime string `json:"runtime" yaml:"runtime"`
+
+	// Commands run through sh -c in the project directory
+	Install string `json:"install" yaml:"install"`
+	Build   string `json:"build" yaml:"build"`
+	Start   string `json:"start" yaml:"start"`
+
+	// Output is the directory holding the built site
+	Output string `json:"output" yaml:"output"`
+
+	Env         map[string]string  `json:"env" yaml:"env"`
+	HealthCheck *model.HealthCheck `json:"health_check" yaml:"health_check"`
+	// SPA serves index.html for unknown paths, on unless set to false
+	SPA *bool `json:"spa" yaml:"spa"`
+
+	Go *GoOptions `json:"go" yaml:"go"`
+}
+
+// loadManifest reads the manifest in repoDir. It returns nil without an
+// error when the repository has none.
+func loadManifest(repoDir string) (*Manifest, error) {
+	var found []string
+	for _, name := range manifestFiles {
+		if _, err := os.Stat(filepath.Join(repoDir, name)); err == nil {
+			found = append(found, name)
+		}
+	}
+	if len(found) == 0 {
+		return nil, nil
+	}
+	if len(found) > 1 {
+		return nil, fmt.Errorf("found %s, only one manifest is allowed", strings.Join(found, " and "))
+	}
+
+	name := found[0]
+	data, err := os.ReadFile(filepath.Join(repoDir, name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", name, err)
+	}
+
+	var m Manifest
+	if filepath.Ext(name) == ".json" {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&m)
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		err = dec.Decode(&m)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", name, err)
+	}
+
+	if errs := m.validate(repoDir); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid %s:\n  %s", name, strings.Join(errs, "\n  "))
+	}
+	return &m, nil
+}
+
+// validate returns every problem with the manifest rather than the first
+func (m *Manifest) validate(repoDir string) []string {
+	var errs []string
+
+	if m.Root != "" {
+		root := filepath.Clean(m.Root)
+		if filepath.IsAbs(root) || root == ".." || strings.HasPrefix(root, ".."+string(filepath.Separator)) {
+			errs = append(errs, "root must be a directory inside the repository")
+		} else if info, err := os.Stat(filepath.Join(repoDir, root)); err != nil || !info.IsDir() {
+			errs = append(errs, fmt.Sprintf("root %q does not exist", m.Root))
+		}
+	}
+
+	switch m.Type {
+	case "", "node", "go", "python", "static", "docker":
+	default:
+		errs = append(errs, fmt.Sprintf("unknown type %q, expected node, go, python, static or docker", m.Type))
+	}
+	if m.Type == "static" && m.Start != "" {
+		errs = append(errs, "static sites have no start command")
+	}
+	if m.Type == "docker" && (m.Install != "" || m.Build != "") {
+		errs = append(errs, "docker projects are built from their Dockerfile, install and build are not supported")
+	}
+	if strings.ContainsAny(m.Runtime, " /:") {
+		errs = append(errs, fmt.Sprintf("invalid runtime version %q", m.Runtime))
+	}
+
+	if m.Output != "" {
+		output := filepath.Clean(m.Output)
+		if filepath.IsAbs(output) || output == ".." || strings.HasPrefix(output, ".."+string(filepath.Separator)) {
+			errs = append(errs, "output must be a directory inside the project")
+		}
+	}
+
+	keys := make([]string, 0, len(m.Env))
+	for key := range m.Env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !envKeyPattern.MatchString(key) {
+			errs = append(errs, fmt.Sprintf("invalid variable name %q in env", key))
+		}
+	}
+
+	if m.Go != nil {
+		projectDir := filepath.Join(repoDir, filepath.Clean(m.Root))
+		errs = append(errs, m.Go.validate(projectDir)...)
+	}
+
+	if m.HealthCheck != nil {
+		if err := validateHealthCheck(*m.HealthCheck); err != nil {
+			errs = append(errs, "health_check: "+err.Error())
+		}
+	}
+	return errs
+}
+
+// envList returns the manifest's variables as KEY=value pairs
+func (m *Manifest) envList() []string {
+	env := make([]string, 0, len(m.Env))
+	for key, value := range m.Env {
+		env = append(env, key+"="+value)
+	}
+	sort.Strings(env)
+	return env
+}
+
+// spaFallback reports whether unknown paths should serve index.html
+func (m *Manifest) spaFallback() bool {
+	return m.SPA == nil || *m.SPA
+}
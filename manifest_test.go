@@ -0,0 +1,121 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/RajBhut/go-basics/model"
+)
+
+// exampleManifest uses every field a hoster.yaml can have
+const exampleManifest = `root: apps/web
+type: node
+runtime: "20"
+install: npm ci
+build: npm run build
+start: node server.js
+output: dist
+env:
+  NODE_ENV: production
+  API_URL: https://api.example.com
+health_check:
+  type: http
+  path: /healthz
+  expected_status: 200
+  timeout: 30
+  interval: 10
+  failure_threshold: 3
+spa: false
+`
+
+func writeManifest(t *testing.T, name, content string) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "apps", "web"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestLoadManifestYAMLExample(t *testing.T) {
+	m, err := loadManifest(writeManifest(t, "hoster.yaml", exampleManifest))
+	if err != nil {
+		t.Fatalf("loadManifest: %v", err)
+	}
+
+	spa := false
+	want := &Manifest{
+		Root:    "apps/web",
+		Type:    "node",
+		Runtime: "20",
+		Install: "npm ci",
+		Build:   "npm run build",
+		Start:   "node server.js",
+		Output:  "dist",
+		Env: map[string]string{
+			"NODE_ENV": "production",
+			"API_URL":  "https://api.example.com",
+		},
+		HealthCheck: &model.HealthCheck{
+			Type:             "http",
+			Path:             "/healthz",
+			ExpectedStatus:   200,
+			Timeout:          30,
+			Interval:         10,
+			FailureThreshold: 3,
+		},
+		SPA: &spa,
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("got %+v\nwant %+v", m, want)
+	}
+}
+
+func TestLoadManifestJSONHealthCheck(t *testing.T) {
+	content := `{"type": "python", "health_check": {"type": "tcp", "timeout": 5, "failure_threshold": 2}}`
+	m, err := loadManifest(writeManifest(t, "hoster.json", content))
+	if err != nil {
+		t.Fatalf("loadManifest: %v", err)
+	}
+	want := model.HealthCheck{Type: "tcp", Timeout: 5, FailureThreshold: 2}
+	if m.HealthCheck == nil || *m.HealthCheck != want {
+		t.Errorf("health check = %+v, want %+v", m.HealthCheck, want)
+	}
+}
+
+func TestLoadManifestErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    string
+	}{
+		{"unknown yaml field", "hoster.yaml", "type: node\nstart_command: x\n", "field start_command not found"},
+		{"unknown health check field", "hoster.yml", "health_check:\n  status: 200\n", "field status not found"},
+		{"unknown json field", "hoster.json", `{"typ": "node"}`, "unknown field"},
+		{"bad type", "hoster.yaml", "type: rust\n", `unknown type "rust"`},
+		{"root outside repo", "hoster.yaml", "root: ../elsewhere\n", "root must be a directory inside the repository"},
+		{"bad health check", "hoster.yaml", "health_check:\n  type: tcp\n  path: /\n", "health_check: path and expected_status only apply to http checks"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadManifest(writeManifest(t, tt.file, tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadManifestMissing(t *testing.T) {
+	m, err := loadManifest(t.TempDir())
+	if m != nil || err != nil {
+		t.Errorf("loadManifest = %v, %v, want nil, nil", m, err)
+	}
+}
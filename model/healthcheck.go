@@ -0,0 +1,18 @@
+package model
+
+// HealthCheck configures how Hoster decides whether a backend app is up.
+// Durations are in seconds.
+type HealthCheck struct {
+	// Type is "http" or "tcp"
+	Type string `json:"type" yaml:"type"`
+	// Path and ExpectedStatus only apply to http checks. An ExpectedStatus
+	// of 0 accepts any 2xx or 3xx response.
+	Path           string `json:"path,omitempty" yaml:"path"`
+	ExpectedStatus int    `json:"expected_status,omitempty" yaml:"expected_status"`
+	// Timeout is how long a new instance gets to become ready
+	Timeout int `json:"timeout,omitempty" yaml:"timeout"`
+	// Interval and FailureThreshold control liveness probing once the
+	// instance serves traffic
+	Interval         int `json:"interval,omitempty" yaml:"interval"`
+	FailureThreshold int `json:"failure_threshold,omitempty" yaml:"failure_threshold"`
+}
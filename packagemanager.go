@@ -0,0 +1,79 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+)
+
+// packageManager knows how to install and run scripts for a Node project
+// without touching its lockfile
+type packageManager struct {
+	Name string
+	// Toolchain is the build image the package manager runs in
+	Toolchain string
+	Install   []string
+}
+
+// run returns the command running a package.json script
+func (pm packageManager) run(script string) []string {
+	return pm.command("run", script)
+}
+
+// command runs the package manager with args. pnpm and Yarn go through
+// corepack, which ships with Node.js but isn't enabled in its images, and
+// picks the version pinned in the packageManager field.
+func (pm packageManager) command(args ...string) []string {
+	switch pm.Name {
+	case "pnpm", "yarn":
+		return append([]string{"corepack", pm.Name}, args...)
+	}
+	return append([]string{pm.Name}, args...)
+}
+
+// detectPackageManager picks the package manager from the packageManager
+// field of package.json, falling back to whichever lockfile is present
+func detectPackageManager(p *projectFiles) packageManager {
+	name, major := "", 0
+	if p.pkg != nil && p.pkg.PackageManager != "" {
+		// e.g. "pnpm@9.1.0" or "yarn@4.2.2+sha256.abc"
+		var ver string
+		name, ver, _ = strings.Cut(p.pkg.PackageManager, "@")
+		major, _ = strconv.Atoi(strings.SplitN(ver, ".", 2)[0])
+	}
+	if name == "" {
+		switch {
+		case p.hasFile("pnpm-lock.yaml"):
+			name = "pnpm"
+		case p.hasFile("yarn.lock"):
+			name = "yarn"
+			if p.hasFile(".yarnrc.yml") {
+				major = 2
+			}
+		case p.hasFile("bun.lockb", "bun.lock"):
+			name = "bun"
+		}
+	}
+
+	switch name {
+	case "pnpm":
+		pm := packageManager{Name: "pnpm", Toolchain: "node"}
+		pm.Install = pm.command("install", "--frozen-lockfile")
+		return pm
+	case "yarn":
+		pm := packageManager{Name: "yarn", Toolchain: "node"}
+		if major >= 2 {
+			pm.Install = pm.command("install", "--immutable")
+		} else {
+			pm.Install = pm.command("install", "--frozen-lockfile")
+		}
+		return pm
+	case "bun":
+		return packageManager{Name: "bun", Toolchain: "bun", Install: []string{"bun", "install", "--frozen-lockfile"}}
+	}
+
+	// npm ci refuses to run without a lockfile
+	if p.hasFile("package-lock.json", "npm-shrinkwrap.json") {
+		return packageManager{Name: "npm", Toolchain: "node", Install: []string{"npm", "ci"}}
+	}
+	return packageManager{Name: "npm", Toolchain: "node", Install: []string{"npm", "install"}}
+}
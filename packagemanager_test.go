@@ -0,0 +1,37 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDetectPackageManager(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		install []string
+		build   []string
+	}{
+		{"pnpm lockfile", map[string]string{"package.json": `{}`, "pnpm-lock.yaml": ""},
+			[]string{"corepack", "pnpm", "install", "--frozen-lockfile"}, []string{"corepack", "pnpm", "run", "build"}},
+		{"yarn berry", map[string]string{"package.json": `{"packageManager": "yarn@4.2.2+sha256.abc"}`},
+			[]string{"corepack", "yarn", "install", "--immutable"}, []string{"corepack", "yarn", "run", "build"}},
+		{"yarn classic", map[string]string{"package.json": `{}`, "yarn.lock": ""},
+			[]string{"corepack", "yarn", "install", "--frozen-lockfile"}, []string{"corepack", "yarn", "run", "build"}},
+		{"bun", map[string]string{"package.json": `{}`, "bun.lock": ""},
+			[]string{"bun", "install", "--frozen-lockfile"}, []string{"bun", "run", "build"}},
+		{"npm", map[string]string{"package.json": `{}`, "package-lock.json": "{}"},
+			[]string{"npm", "ci"}, []string{"npm", "run", "build"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pm := detectPackageManager(newProjectFiles(writeRepo(t, tt.files)))
+			if !slices.Equal(pm.Install, tt.install) {
+				t.Errorf("Install = %v, want %v", pm.Install, tt.install)
+			}
+			if build := pm.run("build"); !slices.Equal(build, tt.build) {
+				t.Errorf("run(build) = %v, want %v", build, tt.build)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+)
+
+// portAllocator hands out ports for backend apps from a fixed range,
+// skipping ports that are already in use, and remembers its assignments
+// across restarts
+type portAllocator struct {
+	mu       sync.Mutex
+	path     string
+	min, max int
+	assigned map[string]int
+}
+
+func newPortAllocator(path string, min, max int) *portAllocator {
+	a := &portAllocator{
+		path:     path,
+		min:      min,
+		max:      max,
+		assigned: make(map[string]int),
+	}
+	if data, err := os.ReadFile(path); err == nil {
+		if err := json.Unmarshal(data, &a.assigned); err != nil {
+			fmt.Printf("Warning: Could not parse %s: %v\n", path, err)
+		}
+	}
+	return a
+}
+
+// portAvailable reports whether nothing is listening on port
+func portAvailable(port int) bool {
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
+	}
+	ln.Close()
+	return true
+}
+
+// allocate returns the port assigned to key, assigning a free one first if
+// needed. A key keeps its port across deployments.
+func (a *portAllocator) allocate(key string) (int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if port, ok := a.assigned[key]; ok {
+		return port, nil
+	}
+
+	taken := make(map[int]bool, len(a.assigned))
+	for _, port := range a.assigned {
+		taken[port] = true
+	}
+	for port := a.min; port <= a.max; port++ {
+		if taken[port] || !portAvailable(port) {
+			continue
+		}
+		a.assigned[key] = port
+		if err := a.save(); err != nil {
+			delete(a.assigned, key)
+			return 0, err
+		}
+		return port, nil
+	}
+	return 0, fmt.Errorf("no free port between %d and %d", a.min, a.max)
+}
+
+// release gives up key's port
+func (a *portAllocator) release(key string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, ok := a.assigned[key]; !ok {
+		return
+	}
+	delete(a.assigned, key)
+	if err := a.save(); err != nil {
+		fmt.Printf("Warning: Failed to save port assignments: %v\n", err)
+	}
+}
+
+// save must be called with a.mu held
+func (a *portAllocator) save() error {
+	data, _ := json.MarshalIndent(a.assigned, "", "  ")
+	if err := os.WriteFile(a.path, data, 0644); err != nil {
+		return fmt.Errorf("failed to save port assignments: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/RajBhut/go-basics/ent"
+	"github.com/gin-gonic/gin"
+)
+
+// githubPullRequestEvent holds the parts of a GitHub pull_request payload
+// we care about
+type githubPullRequestEvent struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Head struct {
+			SHA string `json:"sha"`
+			// Repo is null when the fork was deleted
+			Repo *githubRepository `json:"repo"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+	} `json:"pull_request"`
+	Repository githubRepository `json:"repository"`
+}
+
+// fromFork reports whether the pull request's head lives outside the base
+// repository
+func (e githubPullRequestEvent) fromFork() bool {
+	head := e.PullRequest.Head.Repo
+	return head == nil || !strings.EqualFold(head.FullName, e.Repository.FullName)
+}
+
+// previewName is the site name of a pull request preview, served at
+// pr-<n>--<project>.hoster.localhost
+func previewName(projectName string, number int) string {
+	return fmt.Sprintf("pr-%d--%s", number, projectName)
+}
+
+// forkPreviewRefusal says why a pull request from a fork can't be previewed
+// for p, empty if it can. Its code is untrusted, so the project has to opt
+// in and builds have to run in containers.
+func forkPreviewRefusal(p *ent.Project) string {
+	if !p.ForkPreviews {
+		return "previews of forks are disabled for this project"
+	}
+	if !isolatedBuilds() {
+		return "previews of forks need HOSTER_BUILDER=container"
+	}
+	return ""
+}
+
+// Builds a preview when a pull request is opened or pushed to and tears it
+// down when the pull request is closed
+func handlePullRequestEvent(c *gin.Context, body []byte) {
+	var event githubPullRequestEvent
+	if err := json.Unmarshal(body, &event); err != nil || event.Number == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid payload"})
+		return
+	}
+
+	projects, err := findRepoProjects(event.Repository)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not look up projects"})
+		return
+	}
+
+	switch event.Action {
+	case "opened", "reopened", "synchronize":
+		var deployments []string
+		skipped := []gin.H{}
+		for _, p := range projects {
+			if projectBranch(p, event.Repository) != event.PullRequest.Base.Ref {
+				continue
+			}
+			if event.fromFork() {
+				if reason := forkPreviewRefusal(p); reason != "" {
+					fmt.Printf("Skipping preview of #%d for %s: %s\n", event.Number, p.Name, reason)
+					skipped = append(skipped, gin.H{"project": p.Name, "reason": reason})
+					continue
+				}
+			}
+
+			ref := fmt.Sprintf("refs/pull/%d/head", event.Number)
+			job := newDeployJob(p.Owner, p.Repo, ref, os.Getenv("GITHUB_DEPLOY_TOKEN"))
+			job.Project = p.Name
+			job.Environment = environmentPreview
+			job.PRNumber = event.Number
+			job.Fork = event.fromFork()
+			if err := startDeployment(job); err != nil {
+				c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+				return
+			}
+			deployments = append(deployments, job.ID)
+		}
+		c.JSON(http.StatusAccepted, gin.H{
+			"message":     fmt.Sprintf("Queued %d preview deployment(s)", len(deployments)),
+			"deployments": deployments,
+			"skipped":     skipped,
+		})
+
+	case "closed":
+		var removed []string
+		for _, p := range projects {
+			name := previewName(p.Name, event.Number)
+			if err := teardownPreview(name); err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+			removed = append(removed, name)
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "Previews removed", "previews": removed})
+
+	default:
+		c.JSON(http.StatusAccepted, gin.H{"message": "action ignored"})
+	}
+}
+
+// Lets the owner build previews of pull requests from forks
+func setForkPreviewsHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+
+	var requestBody struct {
+		Enabled *bool `json:"enabled" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	if err := p.Update().SetForkPreviews(*requestBody.Enabled).Exec(dbCtx); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not update project"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Fork previews updated", "enabled": *requestBody.Enabled})
+}
+
+// publishPreview routes the preview's host to its live release. The route
+// points at the release link, so it only has to be added once per PR.
+func publishPreview(job *deployJob) (string, error) {
+	name := job.siteName()
+	host := projectHost(name)
+
+	exists, err := caddyRouteExists(host)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		job.log.Printf("Routing %s\n", host)
+		if err := registerCaddyRoute(host, projectServeDir(name), loadSiteSettings(name).SPA); err != nil {
+			return "", err
+		}
+	}
+	return fmt.Sprintf("http://%s", host), nil
+}
+
+// teardownPreview removes a preview's Caddy route, files and any backend
+// process it runs
+func teardownPreview(name string) error {
+	if err := teardownSite(name); err != nil {
+		return fmt.Errorf("failed to remove preview: %v", err)
+	}
+	fmt.Printf("Removed preview %s\n", name)
+	return nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/RajBhut/go-basics/caddy"
+	"github.com/RajBhut/go-basics/caddy/caddytest"
+	"github.com/RajBhut/go-basics/ent"
+)
+
+// setupCaddyTest points deployments at a fake Caddy serving routes and
+// keeps apps, ports, slots and files in a temporary directory
+func setupCaddyTest(t *testing.T, routes ...caddy.Route) {
+	t.Helper()
+	config := caddy.Config{}
+	config.Apps.HTTP = &caddy.HTTPApp{Servers: map[string]*caddy.Server{
+		"srv0": {Listen: []string{":80"}, Routes: routes},
+	}}
+	data, _ := json.Marshal(config)
+	fake, err := caddytest.NewServer(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(fake.Close)
+
+	oldAdmin, oldConfigFile, oldDeployed, oldArtifacts := caddyAdmin, caddyConfigFile, deployedDir, artifactsDir
+	oldApps, oldPorts, oldSlots, oldRunDir, oldLogDir := apps, ports, slots, appRunDir, appLogDir
+	t.Cleanup(func() {
+		caddyAdmin, caddyConfigFile, deployedDir, artifactsDir = oldAdmin, oldConfigFile, oldDeployed, oldArtifacts
+		apps, ports, slots, appRunDir, appLogDir = oldApps, oldPorts, oldSlots, oldRunDir, oldLogDir
+	})
+	dir := t.TempDir()
+	caddyAdmin = caddy.NewClient(fake.URL)
+	caddyConfigFile = filepath.Join(dir, "caddy_config.json")
+	deployedDir = filepath.Join(dir, "Deployed")
+	artifactsDir = filepath.Join(dir, "artifacts")
+	appRunDir = filepath.Join(dir, "run")
+	appLogDir = filepath.Join(dir, "logs")
+	for _, d := range []string{appRunDir, appLogDir} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	apps = newSupervisor()
+	ports = newPortAllocator(filepath.Join(dir, "ports.json"), 9000, 9100)
+	slots = newSlotTracker(filepath.Join(dir, "slots.json"))
+}
+
+func TestPullRequestOpenedQueuesPreview(t *testing.T) {
+	tests := []struct {
+		payload string
+		ref     string
+		site    string
+		fork    bool
+	}{
+		{"pull_request_opened.json", "refs/pull/42/head", "pr-42--site", false},
+		{"pull_request_synchronize.json", "refs/pull/42/head", "pr-42--site", false},
+		{"pull_request_fork.json", "refs/pull/43/head", "pr-43--site", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.payload, func(t *testing.T) {
+			setupIsolatedBuilds(t)
+			setupWebhookTest(t,
+				&ent.Project{Name: "site", Owner: "octo-org", Repo: "site", ForkPreviews: true},
+				&ent.Project{Name: "site-release", Owner: "octo-org", Repo: "site", Branch: "release"},
+			)
+
+			w := sendSignedWebhook("pull_request", readPayload(t, tt.payload))
+			if w.Code != http.StatusAccepted {
+				t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusAccepted, w.Body)
+			}
+			jobs := queuedJobs(t, w)
+			if len(jobs) != 1 {
+				t.Fatalf("queued %d previews, want 1 for the project tracking the base branch", len(jobs))
+			}
+			job := jobs[0]
+			if job.Project != "site" || job.Ref != tt.ref || job.Environment != environmentPreview {
+				t.Errorf("job = %s %s %s, want site %s preview", job.Project, job.Ref, job.Environment, tt.ref)
+			}
+			if job.Fork != tt.fork {
+				t.Errorf("Fork = %v, want %v", job.Fork, tt.fork)
+			}
+			if job.siteName() != tt.site {
+				t.Errorf("site = %s, want %s", job.siteName(), tt.site)
+			}
+		})
+	}
+}
+
+// setupIsolatedBuilds runs builds in containers, which previews of forks
+// need
+func setupIsolatedBuilds(t *testing.T) {
+	t.Helper()
+	old := builder
+	t.Cleanup(func() { builder = old })
+	builder = &containerBuilder{runtime: "docker"}
+}
+
+func TestPullRequestFromForkSkipped(t *testing.T) {
+	tests := []struct {
+		name     string
+		isolated bool
+		optIn    bool
+		reason   string
+	}{
+		{"not opted in", true, false, "previews of forks are disabled for this project"},
+		{"host builder", false, true, "previews of forks need HOSTER_BUILDER=container"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.isolated {
+				setupIsolatedBuilds(t)
+			}
+			setupWebhookTest(t, &ent.Project{Name: "site", Owner: "octo-org", Repo: "site", ForkPreviews: tt.optIn})
+
+			w := sendSignedWebhook("pull_request", readPayload(t, "pull_request_fork.json"))
+			if w.Code != http.StatusAccepted {
+				t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusAccepted, w.Body)
+			}
+			if len(deployQueue.byID) != 0 {
+				t.Errorf("queued %d previews of a fork", len(deployQueue.byID))
+			}
+			var resp struct {
+				Skipped []struct {
+					Project string `json:"project"`
+					Reason  string `json:"reason"`
+				} `json:"skipped"`
+			}
+			json.Unmarshal(w.Body.Bytes(), &resp)
+			if len(resp.Skipped) != 1 || resp.Skipped[0].Reason != tt.reason {
+				t.Errorf("skipped = %+v, want site: %s", resp.Skipped, tt.reason)
+			}
+		})
+	}
+}
+
+func TestPullRequestIgnoredAction(t *testing.T) {
+	setupWebhookTest(t, &ent.Project{Name: "site", Owner: "octo-org", Repo: "site"})
+	body := strings.Replace(string(readPayload(t, "pull_request_opened.json")), `"action": "opened"`, `"action": "labeled"`, 1)
+	w := sendSignedWebhook("pull_request", []byte(body))
+	if w.Code != http.StatusAccepted || len(deployQueue.byID) != 0 {
+		t.Errorf("status = %d with %d deployments, want %d with none", w.Code, len(deployQueue.byID), http.StatusAccepted)
+	}
+}
+
+func TestPullRequestClosedRemovesPreview(t *testing.T) {
+	setupWebhookTest(t, &ent.Project{Name: "site", Owner: "octo-org", Repo: "site"})
+
+	host := projectHost("pr-42--site")
+	route := proxyRoute(host, "localhost:9000")
+	route.ID = routeID(host)
+	setupCaddyTest(t, route)
+
+	previewDir := filepath.Join(deployedDir, "pr-42--site")
+	if err := os.MkdirAll(previewDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	w := sendSignedWebhook("pull_request", readPayload(t, "pull_request_closed.json"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	if _, err := os.Stat(previewDir); !os.IsNotExist(err) {
+		t.Errorf("preview files still exist: %v", err)
+	}
+	routes, err := caddyAdmin.Routes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(routes) != 0 {
+		t.Errorf("routes left after close: %+v", routes)
+	}
+	if len(deployQueue.byID) != 0 {
+		t.Errorf("closing a pull request queued %d deployments", len(deployQueue.byID))
+	}
+}
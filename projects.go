@@ -0,0 +1,178 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/RajBhut/go-basics/caddy"
+	"github.com/RajBhut/go-basics/ent"
+	"github.com/RajBhut/go-basics/ent/deployment"
+	"github.com/RajBhut/go-basics/ent/domain"
+	"github.com/RajBhut/go-basics/ent/envvar"
+	"github.com/RajBhut/go-basics/ent/project"
+	"github.com/gin-gonic/gin"
+)
+
+var errBuildNotFound = errors.New("build not found for this project")
+
+// previewSitePattern matches the sites previewName creates
+var previewSitePattern = regexp.MustCompile(`^pr-\d+--(.+)$`)
+
+// siteProject returns the project a site belongs to, which is the site
+// itself unless it is a preview
+func siteProject(site string) string {
+	if m := previewSitePattern.FindStringSubmatch(site); m != nil {
+		return m[1]
+	}
+	return site
+}
+
+// projectSites returns the project's own site and every preview site of
+// it that has files on disk, a supervised app or a live slot. Backend
+// previews only have the latter two.
+func projectSites(name string) []string {
+	var candidates []string
+	entries, _ := os.ReadDir(deployedDir)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			candidates = append(candidates, entry.Name())
+		}
+	}
+	for _, instance := range apps.names() {
+		candidates = append(candidates, instanceSite(instance))
+	}
+	candidates = append(candidates, slots.sites()...)
+
+	sites := []string{name}
+	seen := map[string]bool{name: true}
+	for _, site := range candidates {
+		if !seen[site] && siteProject(site) == name {
+			seen[site] = true
+			sites = append(sites, site)
+		}
+	}
+	sort.Strings(sites[1:])
+	return sites
+}
+
+// teardownSite removes a site's Caddy route, files and any backend process
+// it runs. The route goes first, so a failure leaves the site serving as
+// before rather than routed to nothing.
+func teardownSite(name string) error {
+	if err := removeCaddyRoute(projectHost(name)); err != nil {
+		return err
+	}
+	removeSiteApps(name)
+	if err := os.RemoveAll(filepath.Join(deployedDir, name)); err != nil {
+		return fmt.Errorf("failed to remove files of %s: %v", name, err)
+	}
+	os.RemoveAll(filepath.Join(artifactsDir, name))
+	return nil
+}
+
+// deleteProjectRecords removes the project with its deployments, variables
+// and domains from the store
+func deleteProjectRecords(p *ent.Project) error {
+	tx, err := db.Tx(dbCtx)
+	if err != nil {
+		return err
+	}
+	deployments, err := tx.Deployment.Query().Where(deployment.HasProjectWith(project.ID(p.ID))).IDs(dbCtx)
+	if err == nil {
+		_, err = tx.Deployment.Delete().Where(deployment.IDIn(deployments...)).Exec(dbCtx)
+	}
+	if err == nil {
+		_, err = tx.EnvVar.Delete().Where(envvar.HasProjectWith(project.ID(p.ID))).Exec(dbCtx)
+	}
+	var domains []*ent.Domain
+	if err == nil {
+		domains, err = tx.Domain.Query().Where(domain.HasProjectWith(project.ID(p.ID))).All(dbCtx)
+	}
+	if err == nil {
+		_, err = tx.Domain.Delete().Where(domain.HasProjectWith(project.ID(p.ID))).Exec(dbCtx)
+	}
+	if err == nil {
+		err = tx.Project.DeleteOneID(p.ID).Exec(dbCtx)
+	}
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	for _, id := range deployments {
+		os.Remove(deploymentLogPath(id))
+	}
+	for _, d := range domains {
+		if err := removeChallengeRoute(d); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+		if !d.Verified {
+			continue
+		}
+		if err := removeTLSPolicy(d.Hostname); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+	}
+	return nil
+}
+
+// Removes a project: its routes, files and processes, previews included,
+// and finally its records
+func deleteProjectHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+	if deployQueue.active(p.Name) {
+		c.JSON(http.StatusConflict, gin.H{"error": "a deployment of this project is in progress"})
+		return
+	}
+
+	for _, site := range projectSites(p.Name) {
+		if err := teardownSite(site); err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if err := deleteProjectRecords(p); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to delete project: %v", err)})
+		return
+	}
+	os.RemoveAll(filepath.Join(goModCacheDir, projectCacheID(p.Owner, p.Name)))
+
+	fmt.Printf("Deleted project %s\n", p.Name)
+	c.JSON(http.StatusOK, gin.H{"message": "Project deleted"})
+}
+
+// desiredSiteRoute is the route a site should have: a proxy to its
+// running app or its static files. It is false when the site has neither.
+func desiredSiteRoute(site string) (caddy.Route, bool) {
+	host := projectHost(site)
+	if spec, ok := apps.spec(liveInstance(site)); ok {
+		return proxyRoute(host, fmt.Sprintf("localhost:%d", spec.Port)), true
+	}
+	root := projectServeDir(site)
+	if _, err := os.Stat(root); err != nil {
+		return caddy.Route{}, false
+	}
+	return staticSiteRoute(host, root, loadSiteSettings(site).SPA), true
+}
+
+// registerSite points the site's host at whatever it currently serves.
+// Registering again replaces the route instead of adding another.
+func registerSite(site string) (string, error) {
+	route, ok := desiredSiteRoute(site)
+	if !ok {
+		return "", errBuildNotFound
+	}
+	host := projectHost(site)
+	return host, setCaddyRoute(host, route)
+}
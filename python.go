@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+// pythonVenv is the virtualenv every Python app is installed into
+const pythonVenv = ".venv"
+
+// pythonInstaller is the tool that installs a Python project's
+// dependencies into the virtualenv
+type pythonInstaller struct {
+	Name string
+	// Lockfile keys the build cache, CacheEnv points the tool at it
+	Lockfile string
+	CacheEnv string
+}
+
+// detectPythonInstaller picks the installer from lockfiles and the tool
+// sections of pyproject.toml
+func detectPythonInstaller(dir string) pythonInstaller {
+	exists := func(name string) bool {
+		_, err := os.Stat(filepath.Join(dir, name))
+		return err == nil
+	}
+	pyproject, _ := os.ReadFile(filepath.Join(dir, "pyproject.toml"))
+
+	switch {
+	case exists("uv.lock") || strings.Contains(string(pyproject), "[tool.uv"):
+		return pythonInstaller{Name: "uv", Lockfile: "uv.lock", CacheEnv: "UV_CACHE_DIR"}
+	case exists("poetry.lock") || strings.Contains(string(pyproject), "[tool.poetry"):
+		return pythonInstaller{Name: "poetry", Lockfile: "poetry.lock", CacheEnv: "POETRY_CACHE_DIR"}
+	case exists("pdm.lock") || strings.Contains(string(pyproject), "[tool.pdm"):
+		return pythonInstaller{Name: "pdm", Lockfile: "pdm.lock", CacheEnv: "PDM_CACHE_DIR"}
+	case exists("Pipfile"):
+		return pythonInstaller{Name: "pipenv", Lockfile: "Pipfile.lock", CacheEnv: "PIP_CACHE_DIR"}
+	case exists("requirements.txt"):
+		return pythonInstaller{Name: "pip", Lockfile: "requirements.txt", CacheEnv: "PIP_CACHE_DIR"}
+	}
+	return pythonInstaller{Name: "pip", Lockfile: "pyproject.toml", CacheEnv: "PIP_CACHE_DIR"}
+}
+
+// venvBin returns the path of an executable inside the virtualenv
+func venvBin(name string) string {
+	if runtime.GOOS == "windows" {
+		return filepath.Join(pythonVenv, "Scripts", name)
+	}
+	return filepath.Join(pythonVenv, "bin", name)
+}
+
+// installPython creates the virtualenv and installs the project into it.
+// Tools other than pip are installed into the virtualenv first, so builds
+// only need a Python interpreter.
+func (j *deployJob) installPython(dir string, installer pythonInstaller) error {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	venv := filepath.Join(absDir, pythonVenv)
+
+	j.log.Printf("Creating virtualenv...\n")
+	if err := j.build(dir, "python", "python", "-m", "venv", pythonVenv); err != nil {
+		return err
+	}
+
+	var install []string
+	var env []string
+	switch installer.Name {
+	case "uv":
+		install = []string{venvBin("uv"), "sync", "--frozen", "--no-dev", "--inexact"}
+		env = []string{"UV_PROJECT_ENVIRONMENT=" + venv}
+	case "poetry":
+		install = []string{venvBin("poetry"), "install", "--no-interaction", "--only", "main"}
+		env = []string{"POETRY_VIRTUALENVS_CREATE=false"}
+	case "pdm":
+		install = []string{venvBin("pdm"), "sync", "--prod", "--no-self"}
+	case "pipenv":
+		install = []string{venvBin("pipenv"), "install", "--deploy"}
+		env = []string{"PIPENV_VENV_IN_PROJECT=1"}
+	default:
+		if _, err := os.Stat(filepath.Join(dir, "requirements.txt")); err == nil {
+			install = []string{venvBin("pip"), "install", "-r", "requirements.txt"}
+		} else {
+			install = []string{venvBin("pip"), "install", "."}
+		}
+	}
+
+	if installer.Name != "pip" {
+		j.log.Printf("Installing %s...\n", installer.Name)
+		if err := j.build(dir, "python", venvBin("pip"), "install", installer.Name); err != nil {
+			return err
+		}
+	}
+
+	j.log.Printf("Installing Python dependencies with %s...\n", installer.Name)
+	saved := j.env
+	j.env = append(append(append([]string{}, saved...), "VIRTUAL_ENV="+venv), env...)
+	err = j.build(dir, "python", install...)
+	j.env = saved
+	return err
+}
+
+var (
+	fastAPIApp   = regexp.MustCompile(`(?m)^(\w+)\s*=\s*FastAPI\(`)
+	flaskApp     = regexp.MustCompile(`(?m)^(\w+)\s*=\s*Flask\(`)
+	flaskFactory = regexp.MustCompile(`(?m)^def\s+create_app\(`)
+
+	// pythonEntrypoints are the files searched for an app object, in order
+	pythonEntrypoints = []string{
+		"main.py", "app.py", "wsgi.py", "asgi.py", "server.py", "application.py",
+		"app/__init__.py", "app/main.py", "src/main.py", "src/app.py",
+	}
+)
+
+// pythonModule turns a file path relative to the project into the module
+// name gunicorn and uvicorn expect
+func pythonModule(path string) string {
+	path = strings.TrimSuffix(filepath.ToSlash(path), ".py")
+	path = strings.TrimSuffix(path, "/__init__")
+	return strings.ReplaceAll(path, "/", ".")
+}
+
+// procfileWeb returns the web process from a Procfile, if there is one
+func procfileWeb(dir string) string {
+	f, err := os.Open(filepath.Join(dir, "Procfile"))
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		name, command, ok := strings.Cut(scanner.Text(), ":")
+		if ok && strings.TrimSpace(name) == "web" {
+			return strings.TrimSpace(command)
+		}
+	}
+	return ""
+}
+
+// pythonStartCommand works out how to serve a Python project: its Procfile
+// web process, gunicorn for Django and Flask, uvicorn for FastAPI or
+// app.py as a last resort. server is the package the command needs and
+// host the address it binds to.
+func pythonStartCommand(dir, host string) (command, server string, err error) {
+	if web := procfileWeb(dir); web != "" {
+		return web, "", nil
+	}
+
+	// Django keeps its WSGI module next to settings.py
+	if _, err := os.Stat(filepath.Join(dir, "manage.py")); err == nil {
+		matches, _ := filepath.Glob(filepath.Join(dir, "*", "wsgi.py"))
+		if len(matches) > 0 {
+			rel, _ := filepath.Rel(dir, matches[0])
+			return fmt.Sprintf("gunicorn %s:application --bind %s:$PORT", pythonModule(rel), host), "gunicorn", nil
+		}
+	}
+
+	for _, entrypoint := range pythonEntrypoints {
+		source, err := os.ReadFile(filepath.Join(dir, entrypoint))
+		if err != nil {
+			continue
+		}
+		module := pythonModule(entrypoint)
+		if m := fastAPIApp.FindSubmatch(source); m != nil {
+			return fmt.Sprintf("uvicorn %s:%s --host %s --port $PORT", module, m[1], host), "uvicorn", nil
+		}
+		if m := flaskApp.FindSubmatch(source); m != nil {
+			return fmt.Sprintf("gunicorn %s:%s --bind %s:$PORT", module, m[1], host), "gunicorn", nil
+		}
+		if flaskFactory.Match(source) {
+			return fmt.Sprintf("gunicorn '%s:create_app()' --bind %s:$PORT", module, host), "gunicorn", nil
+		}
+	}
+
+	// The app is expected to read PORT itself
+	if _, err := os.Stat(filepath.Join(dir, "app.py")); err == nil {
+		return "python app.py", "", nil
+	}
+	return "", "", fmt.Errorf("no Procfile, start command or known Python entrypoint found")
+}
@@ -0,0 +1,319 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RajBhut/go-basics/caddy"
+	"github.com/RajBhut/go-basics/ent/project"
+	"github.com/gin-gonic/gin"
+)
+
+// fallbackCaddyConfig is loaded when Caddy runs without our server and
+// there is no caddy_config.json to restore it from
+const fallbackCaddyConfig = `{"apps":{"http":{"servers":{"srv0":{"listen":[":80"],"routes":[]}}}}}`
+
+// driftReport is the outcome of a reconciliation. Hosts are listed by what
+// had to change in Caddy to match the project store.
+type driftReport struct {
+	CheckedAt time.Time `json:"checked_at"`
+	Added     []string  `json:"added"`
+	Updated   []string  `json:"updated"`
+	Removed   []string  `json:"removed"`
+	// TLSUpdated and TLSRemoved list the hosts whose certificate policies
+	// changed, HTTPSEnabled is set when the server had to be opened for TLS
+	TLSUpdated   []string `json:"tls_updated"`
+	TLSRemoved   []string `json:"tls_removed"`
+	HTTPSEnabled bool     `json:"https_enabled"`
+	// Unmanaged lists hosts routed in Caddy whose project is not in the
+	// store, e.g. sites deployed before it existed. They are left in place.
+	Unmanaged []string `json:"unmanaged"`
+	// Restored is set when Caddy had lost the server and it was loaded
+	// again from caddy_config.json
+	Restored bool `json:"restored"`
+	// FileSynced is set when caddy_config.json differed from Caddy's live
+	// config and was rewritten
+	FileSynced bool   `json:"file_synced"`
+	Error      string `json:"error,omitempty"`
+}
+
+func (r driftReport) drifted() bool {
+	return r.changed() || r.FileSynced
+}
+
+// changed reports whether Caddy itself had to be changed
+func (r driftReport) changed() bool {
+	return len(r.Added)+len(r.Updated)+len(r.Removed)+len(r.TLSUpdated)+len(r.TLSRemoved) > 0 ||
+		r.HTTPSEnabled || r.Restored
+}
+
+var (
+	lastDriftMu sync.Mutex
+	lastDrift   *driftReport
+)
+
+// startReconciler reconciles Caddy's routes now and then every interval
+func startReconciler(interval time.Duration) {
+	reconcileAndReport()
+	go func() {
+		for range time.Tick(interval) {
+			reconcileAndReport()
+		}
+	}()
+}
+
+func reconcileAndReport() {
+	report := reconcileRoutes()
+	switch {
+	case report.Error != "":
+		fmt.Printf("Warning: Route reconciliation failed: %s\n", report.Error)
+	case report.drifted():
+		fmt.Printf("Reconciled Caddy routes: %d added, %d updated, %d removed, %d TLS policies changed\n",
+			len(report.Added), len(report.Updated), len(report.Removed), len(report.TLSUpdated)+len(report.TLSRemoved))
+	}
+
+	lastDriftMu.Lock()
+	lastDrift = &report
+	lastDriftMu.Unlock()
+}
+
+// desiredRoutes returns the route every site of every stored project
+// should have, keyed by @id, and the stored projects mapped to whether
+// they are being deployed. Busy projects are left out of the routes, so
+// their routes are neither added nor removed while a deployment switches
+// them.
+func desiredRoutes() (map[string]caddy.Route, map[string]bool, error) {
+	names, err := db.Project.Query().Select(project.FieldName).Strings(dbCtx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list projects: %v", err)
+	}
+
+	desired := map[string]caddy.Route{}
+	projects := map[string]bool{}
+	for _, name := range names {
+		busy := deployQueue != nil && deployQueue.active(name)
+		projects[name] = busy
+		if busy {
+			continue
+		}
+		for _, site := range projectSites(name) {
+			if route, ok := desiredSiteRoute(site); ok {
+				route.ID = routeID(projectHost(site))
+				desired[route.ID] = route
+			}
+		}
+	}
+	return desired, projects, nil
+}
+
+// liveCaddyConfig reads the running config, loading caddy_config.json
+// first when Caddy has lost the server holding our routes
+func liveCaddyConfig(report *driftReport) (*caddy.Config, json.RawMessage, error) {
+	config, raw, err := readCaddyConfig()
+	if err != nil || config.Server(caddyAdmin.Server) != nil {
+		return config, raw, err
+	}
+
+	saved, err := os.ReadFile(caddyConfigFile)
+	if err != nil {
+		saved = []byte(fallbackCaddyConfig)
+	}
+	if err := caddyAdmin.Load(saved); err != nil {
+		return nil, nil, fmt.Errorf("failed to restore Caddy config: %v", err)
+	}
+	report.Restored = true
+
+	config, raw, err = readCaddyConfig()
+	if err == nil && config.Server(caddyAdmin.Server) == nil {
+		err = fmt.Errorf("%s has no %s server", caddyConfigFile, caddyAdmin.Server)
+	}
+	return config, raw, err
+}
+
+func readCaddyConfig() (*caddy.Config, json.RawMessage, error) {
+	raw, err := caddyAdmin.Config()
+	if err != nil {
+		return nil, nil, err
+	}
+	var config caddy.Config
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Caddy config: %v", err)
+	}
+	return &config, raw, nil
+}
+
+// routeSite returns the site a managed route serves
+func routeSite(route caddy.Route) (string, bool) {
+	for _, m := range route.Match {
+		for _, host := range m.Host {
+			if site, ok := strings.CutSuffix(host, projectHost("")); ok && site != "" {
+				return site, true
+			}
+		}
+	}
+	return "", false
+}
+
+func sameRoute(a, b caddy.Route) bool {
+	x, _ := json.Marshal(a)
+	y, _ := json.Marshal(b)
+	return bytes.Equal(x, y)
+}
+
+// reconcileRoutes makes Caddy's routes match the project store with as few
+// admin API calls as possible: only missing or changed routes are
+// upserted and only routes of stored projects' sites that are gone are
+// removed. Routes not under *.hoster.localhost are left alone.
+func reconcileRoutes() driftReport {
+	report := driftReport{
+		CheckedAt:  time.Now(),
+		Added:      []string{},
+		Updated:    []string{},
+		Removed:    []string{},
+		TLSUpdated: []string{},
+		TLSRemoved: []string{},
+		Unmanaged:  []string{},
+	}
+	if db == nil {
+		report.Error = "no project store"
+		return report
+	}
+	desired, projects, err := desiredRoutes()
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+	policies, err := desiredPolicies()
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+	return applyRoutes(desired, policies, projects, report)
+}
+
+// applyRoutes diffs desired routes and TLS policies against Caddy's live
+// config and applies the difference. Routes of busy projects and of sites
+// whose project isn't stored are left alone.
+func applyRoutes(desired map[string]caddy.Route, policies map[string]caddy.AutomationPolicy, projects map[string]bool, report driftReport) driftReport {
+	caddyMu.Lock()
+	defer caddyMu.Unlock()
+
+	config, raw, err := liveCaddyConfig(&report)
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+
+	live := map[string]caddy.Route{}
+	legacy := map[string]bool{}
+	for _, route := range config.Server(caddyAdmin.Server).Routes {
+		site, ok := routeSite(route)
+		if !ok {
+			continue
+		}
+		busy, stored := projects[siteProject(site)]
+		if !stored {
+			report.Unmanaged = append(report.Unmanaged, projectHost(site))
+			continue
+		}
+		if busy {
+			continue
+		}
+		if route.ID == "" {
+			legacy[projectHost(site)] = true
+		} else if route.ID == routeID(projectHost(site)) {
+			live[route.ID] = route
+		}
+	}
+
+	ids := make([]string, 0, len(desired))
+	for id := range desired {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		route := desired[id]
+		current, exists := live[id]
+		if exists && sameRoute(current, route) {
+			continue
+		}
+		if err := caddyAdmin.UpsertRoute(route); err != nil {
+			report.Error = fmt.Sprintf("failed to update route %s: %v", id, err)
+			return report
+		}
+		site, _ := routeSite(route)
+		if exists || legacy[projectHost(site)] {
+			report.Updated = append(report.Updated, projectHost(site))
+		} else {
+			report.Added = append(report.Added, projectHost(site))
+		}
+	}
+
+	for id, route := range live {
+		if _, ok := desired[id]; ok {
+			continue
+		}
+		if err := caddyAdmin.RemoveRoute(id); err != nil {
+			report.Error = fmt.Sprintf("failed to remove route %s: %v", id, err)
+			return report
+		}
+		site, _ := routeSite(route)
+		report.Removed = append(report.Removed, projectHost(site))
+	}
+
+	for host := range legacy {
+		if err := removeLegacyRoutes(host); err != nil {
+			report.Error = err.Error()
+			return report
+		}
+		if _, ok := desired[routeID(host)]; !ok {
+			report.Removed = append(report.Removed, host)
+		}
+	}
+	sort.Strings(report.Removed)
+
+	if err := applyPolicies(config, policies, &report); err != nil {
+		report.Error = err.Error()
+		return report
+	}
+
+	changed := report.changed()
+	if !changed {
+		// The file may still be stale, e.g. after Caddy was changed by hand
+		saved, _ := os.ReadFile(caddyConfigFile)
+		report.FileSynced = !sameJSON(saved, raw)
+	}
+	if changed || report.FileSynced {
+		saveCaddySnapshot()
+	}
+	return report
+}
+
+// sameJSON compares two JSON documents ignoring formatting and key order
+func sameJSON(a, b []byte) bool {
+	var x, y interface{}
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// Returns the outcome of the last route reconciliation
+func routeDriftHandler(c *gin.Context) {
+	lastDriftMu.Lock()
+	report := lastDrift
+	lastDriftMu.Unlock()
+	if report == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "routes have not been reconciled yet"})
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
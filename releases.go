@@ -0,0 +1,236 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Every build is kept in Deployed/<name>/releases/<id> and never modified
+// afterwards. Deployed/<name>/current is a symlink to the live release, so
+// going live or rolling back is a single atomic rename.
+
+func releasesDir(projectName string) string {
+	return filepath.Join(deployedDir, projectName, "releases")
+}
+
+func currentLink(projectName string) string {
+	return filepath.Join(deployedDir, projectName, "current")
+}
+
+// projectServeDir returns the directory that should be served for a project,
+// falling back to the old Deployed/<name>/dist layout for projects that were
+// deployed before releases existed
+func projectServeDir(projectName string) string {
+	if _, err := os.Stat(currentLink(projectName)); err == nil {
+		return currentLink(projectName)
+	}
+	return filepath.Join(deployedDir, projectName, "dist")
+}
+
+// createRelease copies sourceDir into a new release directory. The copy is
+// made under a temporary name first so a failed copy never looks like a
+// complete release.
+func createRelease(projectName, releaseID, sourceDir string) error {
+	target := filepath.Join(releasesDir(projectName), releaseID)
+	if _, err := os.Stat(target); err == nil {
+		return fmt.Errorf("release %s already exists", releaseID)
+	}
+
+	tmp := target + ".partial"
+	os.RemoveAll(tmp)
+	if err := copyDirectory(sourceDir, tmp); err != nil {
+		os.RemoveAll(tmp)
+		return fmt.Errorf("failed to copy release files: %v", err)
+	}
+	if err := os.Rename(tmp, target); err != nil {
+		os.RemoveAll(tmp)
+		return fmt.Errorf("failed to finalize release: %v", err)
+	}
+	return nil
+}
+
+// isReleaseName reports whether id is a plain directory name, so that it
+// can't point outside the releases directory
+func isReleaseName(id string) bool {
+	return id != "" && id != "." && id != ".." && !strings.ContainsAny(id, `/\`)
+}
+
+// activateRelease atomically points the project's current link at releaseID
+func activateRelease(projectName, releaseID string) error {
+	if !isReleaseName(releaseID) {
+		return fmt.Errorf("invalid release %q", releaseID)
+	}
+	target := filepath.Join(releasesDir(projectName), releaseID)
+	if info, err := os.Stat(target); err != nil || !info.IsDir() {
+		return fmt.Errorf("release %s not found", releaseID)
+	}
+
+	link := currentLink(projectName)
+	tmpLink := link + ".tmp"
+	os.Remove(tmpLink)
+	if err := os.Symlink(filepath.Join("releases", releaseID), tmpLink); err != nil {
+		return fmt.Errorf("failed to create release link: %v", err)
+	}
+	if err := os.Rename(tmpLink, link); err != nil {
+		os.Remove(tmpLink)
+		return fmt.Errorf("failed to switch release: %v", err)
+	}
+	return nil
+}
+
+// currentRelease returns the ID of the live release, if any
+func currentRelease(projectName string) string {
+	target, err := os.Readlink(currentLink(projectName))
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(target)
+}
+
+type releaseInfo struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"created_at"`
+	Current   bool   `json:"current"`
+}
+
+// listReleases returns a project's releases, newest first
+func listReleases(projectName string) ([]releaseInfo, error) {
+	entries, err := os.ReadDir(releasesDir(projectName))
+	if err != nil {
+		return nil, err
+	}
+
+	type release struct {
+		info    releaseInfo
+		modTime int64
+	}
+	current := currentRelease(projectName)
+	var found []release
+	for _, entry := range entries {
+		if !entry.IsDir() || filepath.Ext(entry.Name()) == ".partial" {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		found = append(found, release{
+			info: releaseInfo{
+				ID:        entry.Name(),
+				CreatedAt: info.ModTime().UTC().Format("2006-01-02T15:04:05Z"),
+				Current:   entry.Name() == current,
+			},
+			modTime: info.ModTime().UnixNano(),
+		})
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].modTime > found[j].modTime })
+
+	releases := make([]releaseInfo, 0, len(found))
+	for _, r := range found {
+		releases = append(releases, r.info)
+	}
+	return releases, nil
+}
+
+// pruneReleases keeps the newest releaseRetention releases and always keeps
+// the live one
+func pruneReleases(projectName string) {
+	releases, err := listReleases(projectName)
+	if err != nil {
+		return
+	}
+	for i, r := range releases {
+		if i < releaseRetention || r.Current {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(releasesDir(projectName), r.ID)); err != nil {
+			fmt.Printf("Warning: Failed to remove old release %s: %v\n", r.ID, err)
+		}
+	}
+}
+
+// publishRelease stores sourceDir as a new release and makes it live
+func publishRelease(projectName, releaseID, sourceDir string) error {
+	if err := os.MkdirAll(releasesDir(projectName), 0755); err != nil {
+		return fmt.Errorf("failed to create releases directory: %v", err)
+	}
+	if err := createRelease(projectName, releaseID, sourceDir); err != nil {
+		return err
+	}
+	if err := activateRelease(projectName, releaseID); err != nil {
+		return err
+	}
+	pruneReleases(projectName)
+	return nil
+}
+
+// siteSettings are the serving options of a static site, taken from the
+// manifest of its latest deployment
+type siteSettings struct {
+	SPA bool `json:"spa"`
+}
+
+func siteSettingsPath(projectName string) string {
+	return filepath.Join(deployedDir, projectName, "site.json")
+}
+
+func loadSiteSettings(projectName string) siteSettings {
+	settings := siteSettings{SPA: true}
+	if data, err := os.ReadFile(siteSettingsPath(projectName)); err == nil {
+		json.Unmarshal(data, &settings)
+	}
+	return settings
+}
+
+// applySiteSettings stores settings for projectName and updates its route
+// if they changed and the site is already routed
+func applySiteSettings(projectName string, settings siteSettings) error {
+	if loadSiteSettings(projectName) == settings {
+		return nil
+	}
+	data, _ := json.MarshalIndent(settings, "", "  ")
+	if err := os.WriteFile(siteSettingsPath(projectName), data, 0644); err != nil {
+		return fmt.Errorf("failed to save site settings: %v", err)
+	}
+
+	host := projectHost(projectName)
+	exists, err := caddyRouteExists(host)
+	if err != nil || !exists {
+		return err
+	}
+	return setCaddyRoute(host, staticSiteRoute(host, projectServeDir(projectName), settings.SPA))
+}
+
+// rollbackRelease re-points a project to releaseID, or to the release
+// before the live one when releaseID is empty. Only releases listReleases
+// knows about can be activated.
+func rollbackRelease(projectName, releaseID string) (string, error) {
+	releases, err := listReleases(projectName)
+	if err != nil {
+		return "", fmt.Errorf("no releases found for %s", projectName)
+	}
+
+	if releaseID == "" {
+		for i, r := range releases {
+			if r.Current && i+1 < len(releases) {
+				releaseID = releases[i+1].ID
+				break
+			}
+		}
+		if releaseID == "" {
+			return "", fmt.Errorf("no earlier release to roll back to")
+		}
+	} else if !slices.ContainsFunc(releases, func(r releaseInfo) bool { return r.ID == releaseID }) {
+		return "", fmt.Errorf("release %q not found", releaseID)
+	}
+
+	if err := activateRelease(projectName, releaseID); err != nil {
+		return "", err
+	}
+	return releaseID, nil
+}
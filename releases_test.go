@@ -0,0 +1,87 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// setupReleasesTest publishes releases r1 and r2 of site from a temporary
+// Deployed directory
+func setupReleasesTest(t *testing.T) {
+	t.Helper()
+	old := deployedDir
+	t.Cleanup(func() { deployedDir = old })
+	deployedDir = filepath.Join(t.TempDir(), "Deployed")
+
+	for _, id := range []string{"r1", "r2"} {
+		source := writeRepo(t, map[string]string{"index.html": id})
+		if err := publishRelease("site", id, source); err != nil {
+			t.Fatalf("publishRelease %s: %v", id, err)
+		}
+	}
+	// Releases are ordered by modification time
+	earlier := time.Now().Add(-time.Hour)
+	os.Chtimes(filepath.Join(releasesDir("site"), "r1"), earlier, earlier)
+}
+
+func TestRollbackRelease(t *testing.T) {
+	setupReleasesTest(t)
+
+	id, err := rollbackRelease("site", "")
+	if err != nil || id != "r1" {
+		t.Fatalf("rollbackRelease = %q, %v, want r1", id, err)
+	}
+	if id, err := rollbackRelease("site", "r2"); err != nil || id != "r2" {
+		t.Fatalf("rollbackRelease r2 = %q, %v", id, err)
+	}
+	content, err := os.ReadFile(filepath.Join(projectServeDir("site"), "index.html"))
+	if err != nil || string(content) != "r2" {
+		t.Errorf("serving %q, %v, want r2", content, err)
+	}
+}
+
+func TestRollbackReleaseOutsideReleases(t *testing.T) {
+	setupReleasesTest(t)
+
+	for _, id := range []string{"../../..", "..", ".", "r1/..", "/etc", "r3"} {
+		if _, err := rollbackRelease("site", id); err == nil {
+			t.Errorf("rollbackRelease accepted %q", id)
+		}
+		if err := activateRelease("site", id); err == nil {
+			t.Errorf("activateRelease accepted %q", id)
+		}
+	}
+	if current := currentRelease("site"); current != "r2" {
+		t.Errorf("current release = %q, want r2", current)
+	}
+}
+
+func TestCreateReleaseSkipsSymlinks(t *testing.T) {
+	setupReleasesTest(t)
+
+	secret := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(secret, []byte("HOSTER_SECRET_KEY=x"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	source := writeRepo(t, map[string]string{"index.html": "r3"})
+	if err := os.Symlink(secret, filepath.Join(source, "leak")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Dir(secret), filepath.Join(source, "leakdir")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := publishRelease("site", "r3", source); err != nil {
+		t.Fatalf("publishRelease: %v", err)
+	}
+	for _, name := range []string{"leak", "leakdir"} {
+		if _, err := os.Lstat(filepath.Join(projectServeDir("site"), name)); !os.IsNotExist(err) {
+			t.Errorf("%s was published: %v", name, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(projectServeDir("site"), "index.html")); err != nil {
+		t.Errorf("index.html missing: %v", err)
+	}
+}
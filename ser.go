@@ -1,13 +1,13 @@
 package main
 
 import (
-	"bytes"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -28,6 +28,30 @@ var (
 	state             = "randomstate"
 	deploymentRootDir = "deployments"
 	deployedDir       = "Deployed"
+	deploymentLogDir  = "logs/deployments"
+	releaseRetention  = 5
+	appRunDir         = "run/apps"
+	appLogDir         = "logs/apps"
+	apps              *supervisor
+	portsFile         = "run/ports.json"
+	appPortMin        = 9000
+	appPortMax        = 9999
+	ports             *portAllocator
+	slotsFile         = "run/slots.json"
+	slots             *slotTracker
+	drainGracePeriod  = 30 * time.Second
+	deployWorkers     = 2
+	deployQueueSize   = 32
+	deployQueue       *jobQueue
+	builder           Builder
+	buildCacheDir     = "cache/builds"
+	buildCacheMaxMB   = 4096
+	buildCaches       *buildCache
+	artifactsDir      = "artifacts"
+	goModCacheDir     = "cache/gomod"
+	containers        ContainerRuntime
+	reconcileInterval = 5 * time.Minute
+	tlsOptions        tlsSettings
 )
 
 func main() {
@@ -39,6 +63,26 @@ func main() {
 	// Create necessary directories
 	os.MkdirAll(deploymentRootDir, 0755)
 	os.MkdirAll(deployedDir, 0755)
+	os.MkdirAll(deploymentLogDir, 0755)
+	os.MkdirAll(appRunDir, 0755)
+	os.MkdirAll(appLogDir, 0755)
+	os.MkdirAll(artifactsDir, 0755)
+	os.MkdirAll(goModCacheDir, 0755)
+
+	db, dbCtx = initiate_db()
+	defer db.Close()
+
+	builder = newBuilderFromEnv()
+	buildCaches = newBuildCache(buildCacheDir, buildCacheMaxMB)
+	containers = newContainerRuntimeFromEnv()
+	tlsOptions = tlsSettingsFromEnv()
+	ports = newPortAllocator(portsFile, appPortMin, appPortMax)
+	apps = newSupervisor()
+	slots = newSlotTracker(slotsFile)
+	apps.restore()
+	retireIdleSlots()
+	startReconciler(reconcileInterval)
+	deployQueue = newJobQueue(deployWorkers, deployQueueSize)
 
 	r := gin.Default()
 
@@ -56,6 +100,8 @@ func main() {
 	r.GET("/github/callback", githubCallback)
 
 	r.POST("/deploy", selectRepoHandler)
+	r.GET("/deployments/:id", deploymentStatusHandler)
+	r.GET("/deployments/:id/logs", deploymentLogsHandler)
 	r.GET("/refresh", refreshHandler)
 	r.POST("/logout", logoutHandler)
 	r.GET("/repo-details", getRepoDetailsHandler)
@@ -70,47 +116,57 @@ func main() {
 
 	// Deployed projects routes
 	r.GET("/deployed-projects", func(c *gin.Context) {
-		var projects []gin.H
-
-		// Check the permanent Deployed directory
-		deployedEntries, err := os.ReadDir(deployedDir)
-		if err == nil {
-			for _, entry := range deployedEntries {
-				if entry.IsDir() {
-					projectName := entry.Name()
-					projectPath := filepath.Join(deployedDir, projectName)
-
-					// Try to find the index.html file
-					var indexPath string
-					for _, buildDir := range []string{"dist", "build", "public", "out", "_site", ""} {
-						if buildDir != "" {
-							testPath := filepath.Join(projectPath, buildDir, "index.html")
-							if _, err := os.Stat(testPath); err == nil {
-								indexPath = testPath
-								break
-							}
-						} else {
-							testPath := filepath.Join(projectPath, "index.html")
-							if _, err := os.Stat(testPath); err == nil {
-								indexPath = testPath
-								break
-							}
-						}
-					}
-
-					if indexPath != "" {
-						projects = append(projects, gin.H{
-							"name":      projectName,
-							"url":       fmt.Sprintf("/projects/%s", projectName),
-							"timestamp": entry.Name(),
-						})
-					}
-				}
-			}
+		projects, err := listProjects()
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "could not list projects"})
+			return
 		}
-
 		c.JSON(http.StatusOK, gin.H{"projects": projects})
 	})
+	r.GET("/projects/:name/deployments", func(c *gin.Context) {
+		if _, ok := requireProjectOwner(c); !ok {
+			return
+		}
+		deployments, err := listProjectDeployments(c.Param("name"))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "could not list deployments"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"deployments": deployments})
+	})
+	r.GET("/projects/:name/releases", func(c *gin.Context) {
+		p, ok := requireProjectOwner(c)
+		if !ok {
+			return
+		}
+		releases, err := listReleases(p.Name)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "no releases found"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"releases": releases})
+	})
+	r.POST("/projects/:name/rollback", rollbackHandler)
+	r.DELETE("/projects/:name", deleteProjectHandler)
+	r.PUT("/projects/:name/branch", setProjectBranchHandler)
+	r.PUT("/projects/:name/fork-previews", setForkPreviewsHandler)
+	r.GET("/projects/:name/domains", listDomainsHandler)
+	r.POST("/projects/:name/domains", addDomainHandler)
+	r.POST("/projects/:name/domains/:domain/verify", verifyDomainHandler)
+	r.DELETE("/projects/:name/domains/:domain", deleteDomainHandler)
+	r.GET("/projects/:name/env", listEnvHandler)
+	r.PUT("/projects/:name/env", setEnvHandler)
+	r.DELETE("/projects/:name/env/:key", deleteEnvHandler)
+	r.GET("/projects/:name/status", appStatusHandler)
+	r.POST("/projects/:name/start", startAppHandler)
+	r.POST("/projects/:name/stop", stopAppHandler)
+	r.POST("/projects/:name/restart", restartAppHandler)
+	r.GET("/projects/:name/health-check", getHealthCheckHandler)
+	r.PUT("/projects/:name/health-check", setHealthCheckHandler)
+	r.GET("/routes/drift", routeDriftHandler)
+	r.GET("/apps", listAppsHandler)
+
+	r.POST("/webhooks/github", githubWebhookHandler)
 	r.GET("project/:projectname", serv_react)
 	r.GET("project/:projectname/:path", func(c *gin.Context) {
 
@@ -118,13 +174,13 @@ func main() {
 	r.GET("/project/:projectname/assets/*filepath", func(c *gin.Context) {
 		project := c.Param("projectname")
 		file := c.Param("filepath")
-		assetPath := filepath.Join("Deployed", project, "dist", "assets", file)
+		assetPath := filepath.Join(projectServeDir(project), "assets", file)
 		if _, err := os.Stat(assetPath); err == nil {
 			fmt.Println("got this file", assetPath)
 			c.File(assetPath)
 			return
 		} else {
-			c.File(filepath.Join("Deployed", project, "dist", "index.html"))
+			c.File(filepath.Join(projectServeDir(project), "index.html"))
 		}
 
 	})
@@ -136,89 +192,20 @@ func main() {
 			return
 		}
 
-		// Path where the built React project is stored (adjust as needed)
-		targetPath := filepath.Join("Deployed", req.ProjectName, "dist")
-		targetPath = filepath.ToSlash(targetPath) // Ensure forward slashes for Caddy
-
-		// Ensure directory exists
-		if _, err := os.Stat(targetPath); os.IsNotExist(err) {
+		// Registering twice replaces the project's route
+		host, err := registerSite(req.ProjectName)
+		if errors.Is(err, errBuildNotFound) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Build not found for this project"})
 			return
 		}
-
-		// Load existing Caddy config
-		configFile := "caddy_config.json"
-		data, err := os.ReadFile(configFile)
 		if err != nil {
-			c.JSON(500, gin.H{"error": "Failed to read Caddy config"})
-			return
-		}
-
-		var config map[string]interface{}
-		if err := json.Unmarshal(data, &config); err != nil {
-			c.JSON(500, gin.H{"error": "Failed to parse Caddy config"})
-			return
-		}
-
-		projectHost := req.ProjectName + ".hoster.localhost"
-
-		// THIS IS THE KEY CHANGE - FIXING THE ORDER OF ROUTES:
-		// First the file_server, then the rewrite fallback
-		newRoute := map[string]interface{}{
-			"match": []map[string]interface{}{
-				{"host": []string{projectHost}},
-			},
-			"handle": []map[string]interface{}{
-				{
-					"handler": "subroute",
-					"routes": []map[string]interface{}{
-						{
-							// FIRST: Try to serve the actual files
-							"handle": []map[string]interface{}{
-								{
-									"handler": "file_server",
-									"root":    targetPath,
-								},
-							},
-						},
-						{
-							// SECOND: Only if file not found, fall back to index.html
-							"match": []map[string]interface{}{
-								{"not": []map[string]interface{}{
-									{"file": map[string]interface{}{"try_files": []string{"{http.request.uri.path}"}}},
-								}},
-							},
-							"handle": []map[string]interface{}{
-								{"handler": "rewrite", "uri": "/index.html"},
-							},
-						},
-					},
-				},
-			},
-		}
-
-		// Append new route to config
-		servers := config["apps"].(map[string]interface{})["http"].(map[string]interface{})["servers"].(map[string]interface{})
-		srv0 := servers["srv0"].(map[string]interface{})
-		routes := srv0["routes"].([]interface{})
-		srv0["routes"] = append(routes, newRoute)
-
-		// Save updated config
-		newConfigBytes, _ := json.MarshalIndent(config, "", "  ")
-		if err := os.WriteFile(configFile, newConfigBytes, 0644); err != nil {
-			c.JSON(500, gin.H{"error": "Failed to update Caddy config file"})
-			return
-		}
-
-		resp, err := http.Post("http://localhost:2019/load", "application/json", bytes.NewBuffer(newConfigBytes))
-		if err != nil || resp.StatusCode >= 400 {
-			c.JSON(500, gin.H{"error": "Caddy reload failed"})
+			c.JSON(500, gin.H{"error": err.Error()})
 			return
 		}
 
 		c.JSON(http.StatusOK, gin.H{
 			"message": "Project registered successfully",
-			"url":     fmt.Sprintf("http://%s", projectHost),
+			"url":     fmt.Sprintf("http://%s", host),
 		})
 	})
 	r.Run(":8000")
@@ -278,6 +265,6 @@ func findIndexHTML(dir string) string {
 
 func serv_react(c *gin.Context) {
 	project := c.Param("projectname")
-	indexpath := filepath.Join("Deployed", project, "dist", "index.html")
+	indexpath := filepath.Join(projectServeDir(project), "index.html")
 	c.File(indexpath)
 }
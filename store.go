@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/RajBhut/go-basics/ent"
+	"github.com/RajBhut/go-basics/ent/deployment"
+	"github.com/RajBhut/go-basics/ent/project"
+	"github.com/RajBhut/go-basics/ent/user"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	db    *ent.Client
+	dbCtx context.Context
+)
+
+// errProjectTaken is returned when a deploy would reuse a project name that
+// belongs to another owner
+var errProjectTaken = errors.New("a project with this name belongs to another owner")
+
+// saveProject creates the project on its first deployment and links it to
+// the owning user when we know about them. Only the owner can deploy to an
+// existing project, anyone else with a repo of the same name is refused.
+func saveProject(job *deployJob) (*ent.Project, error) {
+	p, err := db.Project.Query().Where(project.Name(job.Project)).Only(dbCtx)
+	if ent.IsNotFound(err) {
+		create := db.Project.Create().
+			SetName(job.Project).
+			SetOwner(job.Owner).
+			SetRepo(job.Repo)
+		if u, err := db.User.Query().Where(user.Username(job.Owner)).Only(dbCtx); err == nil {
+			create.SetUser(u)
+		}
+		return create.Save(dbCtx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if p.Owner != job.Owner {
+		return nil, errProjectTaken
+	}
+	return p.Update().SetRepo(job.Repo).Save(dbCtx)
+}
+
+// createDeploymentRecord stores a freshly queued job
+func createDeploymentRecord(job *deployJob) error {
+	if db == nil {
+		return nil
+	}
+
+	p, err := saveProject(job)
+	if errors.Is(err, errProjectTaken) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save project: %v", err)
+	}
+
+	create := db.Deployment.Create().
+		SetID(job.ID).
+		SetOwner(job.Owner).
+		SetRepo(job.Repo).
+		SetRef(job.Ref).
+		SetEnvironment(job.Environment).
+		SetStatus(statusQueued).
+		SetProject(p)
+	if job.PRNumber != 0 {
+		create.SetPrNumber(job.PRNumber)
+	}
+	if u, err := db.User.Query().Where(user.Username(job.Owner)).Only(dbCtx); err == nil {
+		create.SetUser(u)
+	}
+	if _, err := create.Save(dbCtx); err != nil {
+		return fmt.Errorf("failed to save deployment: %v", err)
+	}
+	return nil
+}
+
+// updateDeploymentRecord mirrors the job's current state into the database.
+// Once a deployment goes live the project is pointed at it.
+func updateDeploymentRecord(job *deployJob) {
+	if db == nil {
+		return
+	}
+
+	job.mu.RLock()
+	status, url, errMsg := job.status, job.url, job.err
+	projectType, buildDir, commitSHA := job.projectType, job.buildDir, job.commitSHA
+	cacheStatus := job.cacheStatus
+	job.mu.RUnlock()
+
+	err := db.Deployment.UpdateOneID(job.ID).
+		SetStatus(status).
+		SetURL(url).
+		SetError(errMsg).
+		SetCommitSha(commitSHA).
+		SetProjectType(projectType).
+		SetBuildDir(buildDir).
+		SetCacheStatus(cacheStatus).
+		Exec(dbCtx)
+	if err != nil {
+		fmt.Printf("Warning: Failed to update deployment %s: %v\n", job.ID, err)
+		return
+	}
+
+	// Previews never change what the project itself serves
+	if status != statusLive || job.Environment != environmentProduction {
+		return
+	}
+	update := db.Project.Update().
+		Where(project.Name(job.Project)).
+		SetURL(url).
+		SetProjectType(projectType).
+		SetBuildDir(buildDir)
+	if release := currentRelease(job.Project); release != "" {
+		update.SetCurrentRelease(release)
+	}
+	err = update.Exec(dbCtx)
+	if err != nil {
+		fmt.Printf("Warning: Failed to update project %s: %v\n", job.Project, err)
+	}
+}
+
+// setCurrentReleaseRecord records which release a project is serving
+func setCurrentReleaseRecord(projectName, releaseID string) {
+	if db == nil {
+		return
+	}
+	err := db.Project.Update().
+		Where(project.Name(projectName)).
+		SetCurrentRelease(releaseID).
+		Exec(dbCtx)
+	if err != nil {
+		fmt.Printf("Warning: Failed to update project %s: %v\n", projectName, err)
+	}
+}
+
+func deploymentRecordJSON(d *ent.Deployment) gin.H {
+	return gin.H{
+		"id":           d.ID,
+		"owner":        d.Owner,
+		"repo":         d.Repo,
+		"ref":          d.Ref,
+		"environment":  d.Environment,
+		"pr_number":    d.PrNumber,
+		"commit_sha":   d.CommitSha,
+		"project_type": d.ProjectType,
+		"build_dir":    d.BuildDir,
+		"cache_status": d.CacheStatus,
+		"status":       d.Status,
+		"deploy_url":   d.URL,
+		"error":        d.Error,
+		"created_at":   d.CreatedAt,
+		"updated_at":   d.UpdatedAt,
+	}
+}
+
+// listProjects returns every project together with its most recent deployment
+func listProjects() ([]gin.H, error) {
+	projects, err := db.Project.Query().
+		WithDeployments(func(q *ent.DeploymentQuery) {
+			q.Order(ent.Desc(deployment.FieldCreatedAt))
+		}).
+		Order(ent.Asc(project.FieldName)).
+		All(dbCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []gin.H{}
+	for _, p := range projects {
+		entry := gin.H{
+			"name":            p.Name,
+			"owner":           p.Owner,
+			"repo":            p.Repo,
+			"project_type":    p.ProjectType,
+			"build_dir":       p.BuildDir,
+			"url":             p.URL,
+			"current_release": p.CurrentRelease,
+			"created_at":      p.CreatedAt,
+			"updated_at":      p.UpdatedAt,
+		}
+		if len(p.Edges.Deployments) > 0 {
+			entry["latest_deployment"] = deploymentRecordJSON(p.Edges.Deployments[0])
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+// listProjectDeployments returns a project's deployment history, newest first
+func listProjectDeployments(name string) ([]gin.H, error) {
+	deployments, err := db.Deployment.Query().
+		Where(deployment.HasProjectWith(project.Name(name))).
+		Order(ent.Desc(deployment.FieldCreatedAt)).
+		All(dbCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []gin.H{}
+	for _, d := range deployments {
+		result = append(result, deploymentRecordJSON(d))
+	}
+	return result, nil
+}
@@ -0,0 +1,626 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RajBhut/go-basics/ent/project"
+	"github.com/RajBhut/go-basics/model"
+	"github.com/gin-gonic/gin"
+)
+
+// States of a supervised app
+const (
+	appStarting = "starting"
+	appRunning  = "running"
+	appBackoff  = "backoff"
+	appStopped  = "stopped"
+)
+
+const (
+	minRestartBackoff = time.Second
+	maxRestartBackoff = time.Minute
+	// An app that stayed up this long is considered healthy again and its
+	// backoff is reset
+	stableRunTime = time.Minute
+	stopTimeout   = 10 * time.Second
+)
+
+// appSpec describes how to run a long-lived app. It is saved
+// next to the pid so apps survive a restart of Hoster itself.
+type appSpec struct {
+	Name        string   `json:"name"`
+	Project     string   `json:"project"`
+	Environment string   `json:"environment"`
+	Dir         string   `json:"dir"`
+	Args        []string `json:"args"`
+	Port        int      `json:"port"`
+	// Untrusted apps, previews of forks, don't get the project's variables
+	Untrusted bool `json:"untrusted,omitempty"`
+	// HealthCheck drives liveness probing while the app runs
+	HealthCheck *model.HealthCheck `json:"health_check,omitempty"`
+	// Vars are the non-secret variables from the repository's manifest
+	Vars []string `json:"vars,omitempty"`
+
+	// Image runs the app as a container instead of Args, which are derived
+	// from it on every start. ContainerPort is the port the image listens
+	// on, PORT when zero. Command replaces the image's CMD.
+	Image         string   `json:"image,omitempty"`
+	ContainerPort int      `json:"container_port,omitempty"`
+	Command       []string `json:"command,omitempty"`
+	// Mounts are host directories mounted into the container at the same
+	// path, the command then runs in Dir
+	Mounts []string `json:"mounts,omitempty"`
+
+	// Env is rebuilt from the project's variables on every start so that
+	// secrets never end up in the state file
+	Env []string `json:"-"`
+}
+
+// appVars returns the manifest's and the project's variables and the port
+// the app has to listen on
+func appVars(spec appSpec) ([]string, error) {
+	var env []string
+	if !spec.Untrusted {
+		var err error
+		if env, err = projectEnv(spec.Project, spec.Environment); err != nil {
+			return nil, err
+		}
+	}
+	return append(append(append([]string{}, spec.Vars...), env...), fmt.Sprintf("PORT=%d", spec.Port)), nil
+}
+
+// appState is what gets persisted in appRunDir for every app
+type appState struct {
+	Spec    appSpec `json:"spec"`
+	PID     int     `json:"pid"`
+	Stopped bool    `json:"stopped"`
+	// StartTime identifies the process behind PID, unlike its command line
+	// it survives an exec
+	StartTime string `json:"start_time,omitempty"`
+}
+
+// managedApp owns one app process and restarts it when it exits
+type managedApp struct {
+	spec appSpec
+
+	mu        sync.Mutex
+	cmd       *exec.Cmd
+	state     string
+	restarts  int
+	startedAt time.Time
+	lastExit  string
+	healthy   bool
+	lastProbe string
+
+	stopCh chan struct{}
+	done   chan struct{}
+}
+
+// supervisor keeps track of every app process Hoster runs
+type supervisor struct {
+	mu   sync.Mutex
+	apps map[string]*managedApp
+}
+
+func newSupervisor() *supervisor {
+	return &supervisor{apps: make(map[string]*managedApp)}
+}
+
+func appStatePath(name string) string {
+	return filepath.Join(appRunDir, name+".json")
+}
+
+func appLogPath(name string) string {
+	return filepath.Join(appLogDir, name+".log")
+}
+
+func saveAppState(state appState) {
+	data, _ := json.MarshalIndent(state, "", "  ")
+	if err := os.WriteFile(appStatePath(state.Spec.Name), data, 0600); err != nil {
+		fmt.Printf("Warning: Failed to save state for %s: %v\n", state.Spec.Name, err)
+	}
+}
+
+// start runs spec under supervision, stopping any instance already running
+// under the same name first
+func (s *supervisor) start(spec appSpec) error {
+	vars, err := appVars(spec)
+	if err != nil {
+		return fmt.Errorf("failed to load environment variables: %v", err)
+	}
+	spec.Env = append(baseEnv(), vars...)
+	if spec.Image != "" {
+		spec.Args, spec.Env = containerArgs(spec, vars)
+	}
+	if len(spec.Args) == 0 {
+		return fmt.Errorf("no command to run for %s", spec.Name)
+	}
+
+	// Opened before the app is registered, an app whose run never starts
+	// would block every later shutdown
+	logFile, err := os.OpenFile(appLogPath(spec.Name), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open app log: %v", err)
+	}
+
+	s.mu.Lock()
+	old := s.apps[spec.Name]
+	app := &managedApp{
+		spec:   spec,
+		state:  appStarting,
+		stopCh: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	s.apps[spec.Name] = app
+	s.mu.Unlock()
+
+	if old != nil {
+		old.shutdown()
+		if old.spec.Dir != spec.Dir {
+			removeWorkspace(old.spec)
+		}
+	}
+
+	started := make(chan error, 1)
+	go app.run(logFile, started)
+	return <-started
+}
+
+// spec returns the spec an app was last started with
+func (s *supervisor) spec(name string) (appSpec, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	app, ok := s.apps[name]
+	if !ok {
+		return appSpec{}, false
+	}
+	return app.spec, true
+}
+
+// stop terminates an app and keeps it stopped until started again
+func (s *supervisor) stop(name string) error {
+	s.mu.Lock()
+	app, ok := s.apps[name]
+	s.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("app %s not found", name)
+	}
+
+	app.shutdown()
+	saveAppState(appState{Spec: app.spec, Stopped: true})
+	return nil
+}
+
+func (s *supervisor) restart(name string) error {
+	s.mu.Lock()
+	app, ok := s.apps[name]
+	s.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("app %s not found", name)
+	}
+	return s.start(app.spec)
+}
+
+// remove stops an app and forgets about it entirely
+func (s *supervisor) remove(name string) {
+	s.mu.Lock()
+	app, ok := s.apps[name]
+	delete(s.apps, name)
+	s.mu.Unlock()
+
+	if ok {
+		app.shutdown()
+		removeContainerApp(app.spec)
+		removeWorkspace(app.spec)
+	}
+	os.Remove(appStatePath(name))
+}
+
+// removeAfter removes an app after delay unless it was replaced in the
+// meantime, and reports whether it did
+func (s *supervisor) removeAfter(name string, delay time.Duration) bool {
+	s.mu.Lock()
+	app, ok := s.apps[name]
+	s.mu.Unlock()
+	if !ok {
+		return false
+	}
+
+	time.Sleep(delay)
+
+	s.mu.Lock()
+	if s.apps[name] != app {
+		s.mu.Unlock()
+		return false
+	}
+	delete(s.apps, name)
+	s.mu.Unlock()
+
+	app.shutdown()
+	removeContainerApp(app.spec)
+	removeWorkspace(app.spec)
+	os.Remove(appStatePath(name))
+	return true
+}
+
+// removeWorkspace deletes the deployment workspace an app ran from once
+// the app is gone. Apps outside deploymentRootDir are left alone.
+func removeWorkspace(spec appSpec) {
+	root, err := filepath.Abs(deploymentRootDir)
+	if err != nil || spec.Dir == "" {
+		return
+	}
+	rel, err := filepath.Rel(root, spec.Dir)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return
+	}
+	// Apps may run from a subdirectory of the repository
+	workspace := filepath.Join(root, strings.Split(rel, string(filepath.Separator))[0])
+	if err := os.RemoveAll(workspace); err != nil {
+		fmt.Printf("Warning: Failed to remove workspace %s: %v\n", workspace, err)
+	}
+}
+
+func (s *supervisor) status(name string) (gin.H, bool) {
+	s.mu.Lock()
+	app, ok := s.apps[name]
+	s.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+	return app.status(), true
+}
+
+// names returns the name of every supervised app, sorted
+func (s *supervisor) names() []string {
+	s.mu.Lock()
+	names := make([]string, 0, len(s.apps))
+	for name := range s.apps {
+		names = append(names, name)
+	}
+	s.mu.Unlock()
+	sort.Strings(names)
+	return names
+}
+
+// list returns the status of every app of the given projects
+func (s *supervisor) list(projects []string) []gin.H {
+	result := []gin.H{}
+	for _, name := range s.names() {
+		spec, ok := s.spec(name)
+		if !ok || !slices.Contains(projects, spec.Project) {
+			continue
+		}
+		if status, ok := s.status(name); ok {
+			result = append(result, status)
+		}
+	}
+	return result
+}
+
+// restore is called at boot. It kills processes left behind by a previous
+// run of Hoster and starts them again under supervision.
+func (s *supervisor) restore() {
+	entries, err := os.ReadDir(appRunDir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		if filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(appRunDir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		var state appState
+		if err := json.Unmarshal(data, &state); err != nil || len(state.Spec.Args) == 0 {
+			continue
+		}
+
+		if state.PID > 0 && isOwnProcess(state) {
+			fmt.Printf("Stopping orphaned process %d of %s\n", state.PID, state.Spec.Name)
+			terminateProcess(state.PID, stopTimeout)
+		}
+
+		if state.Stopped {
+			s.mu.Lock()
+			s.apps[state.Spec.Name] = &managedApp{spec: state.Spec, state: appStopped}
+			s.mu.Unlock()
+			continue
+		}
+		if err := s.start(state.Spec); err != nil {
+			fmt.Printf("Warning: Failed to restore %s: %v\n", state.Spec.Name, err)
+		}
+	}
+}
+
+// isOwnProcess guards against killing an unrelated process that reused a
+// stale pid. States saved without a start time fall back to the command
+// line.
+func isOwnProcess(state appState) bool {
+	if state.StartTime != "" {
+		started, err := processStartTime(state.PID)
+		return err == nil && started == state.StartTime
+	}
+	cmdline, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", state.PID))
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(string(cmdline), state.Spec.Args[0])
+}
+
+// processStartTime returns when a process started in clock ticks since
+// boot, field 22 of /proc/<pid>/stat
+func processStartTime(pid int) (string, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return "", err
+	}
+	// The command name in parentheses may contain spaces, fields are
+	// counted from after it
+	i := strings.LastIndexByte(string(data), ')')
+	fields := strings.Fields(string(data[i+1:]))
+	if i < 0 || len(fields) < 20 {
+		return "", fmt.Errorf("unexpected format of /proc/%d/stat", pid)
+	}
+	return fields[19], nil
+}
+
+// run starts the process and keeps restarting it with exponential backoff
+// until shutdown is called. The first start's result is sent on started.
+func (a *managedApp) run(logFile *os.File, started chan<- error) {
+	defer close(a.done)
+	defer logFile.Close()
+
+	backoff := minRestartBackoff
+	first := true
+	for {
+		// A container left behind by a killed client would hold the name
+		if a.spec.Image != "" {
+			containers.Remove(containerName(a.spec.Name))
+		}
+		cmd := exec.Command(a.spec.Args[0], a.spec.Args[1:]...)
+		cmd.Dir = a.spec.Dir
+		cmd.Env = a.spec.Env
+		cmd.Stdout = logFile
+		cmd.Stderr = logFile
+		setProcessGroup(cmd)
+
+		fmt.Fprintf(logFile, "==> %s starting %s\n", time.Now().Format(time.RFC3339), strings.Join(a.spec.Args, " "))
+		err := cmd.Start()
+		if first {
+			started <- err
+			first = false
+		}
+		if err != nil {
+			a.mu.Lock()
+			a.state = appStopped
+			a.lastExit = err.Error()
+			a.mu.Unlock()
+			return
+		}
+
+		a.mu.Lock()
+		a.cmd = cmd
+		a.state = appRunning
+		a.startedAt = time.Now()
+		a.healthy = false
+		a.lastProbe = ""
+		a.mu.Unlock()
+		startTime, _ := processStartTime(cmd.Process.Pid)
+		saveAppState(appState{Spec: a.spec, PID: cmd.Process.Pid, StartTime: startTime})
+
+		exited := make(chan error, 1)
+		processDone := make(chan struct{})
+		go func() {
+			exited <- cmd.Wait()
+			close(processDone)
+		}()
+		if a.spec.HealthCheck != nil {
+			go a.probeLiveness(cmd.Process.Pid, logFile, processDone)
+		}
+
+		select {
+		case <-a.stopCh:
+			terminateProcess(cmd.Process.Pid, stopTimeout)
+			<-exited
+			if a.spec.Image != "" {
+				containers.Remove(containerName(a.spec.Name))
+			}
+			a.setStopped("stopped")
+			fmt.Fprintf(logFile, "==> %s stopped\n", time.Now().Format(time.RFC3339))
+			return
+		case err := <-exited:
+			exit := "exited"
+			if err != nil {
+				exit = err.Error()
+			}
+			fmt.Fprintf(logFile, "==> %s %s\n", time.Now().Format(time.RFC3339), exit)
+
+			a.mu.Lock()
+			if time.Since(a.startedAt) > stableRunTime {
+				backoff = minRestartBackoff
+			}
+			a.state = appBackoff
+			a.lastExit = exit
+			a.restarts++
+			a.mu.Unlock()
+		}
+
+		select {
+		case <-a.stopCh:
+			a.setStopped("stopped")
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > maxRestartBackoff {
+			backoff = maxRestartBackoff
+		}
+	}
+}
+
+// probeLiveness runs the app's health check every interval until the
+// process exits. After too many failures in a row the process is killed so
+// that run restarts it.
+func (a *managedApp) probeLiveness(pid int, logFile *os.File, processDone <-chan struct{}) {
+	check := healthCheckWithDefaults(a.spec.HealthCheck)
+	ticker := time.NewTicker(time.Duration(check.Interval) * time.Second)
+	defer ticker.Stop()
+
+	failures := 0
+	for {
+		select {
+		case <-processDone:
+			return
+		case <-ticker.C:
+		}
+
+		err := probe(check, a.spec.Port)
+		a.mu.Lock()
+		a.healthy = err == nil
+		if err != nil {
+			a.lastProbe = err.Error()
+		} else {
+			a.lastProbe = ""
+		}
+		a.mu.Unlock()
+
+		if err == nil {
+			failures = 0
+			continue
+		}
+		failures++
+		fmt.Fprintf(logFile, "==> %s health check failed (%d/%d): %v\n", time.Now().Format(time.RFC3339), failures, check.FailureThreshold, err)
+		if failures >= check.FailureThreshold {
+			fmt.Fprintf(logFile, "==> %s unhealthy, restarting\n", time.Now().Format(time.RFC3339))
+			terminateProcess(pid, stopTimeout)
+			return
+		}
+	}
+}
+
+func (a *managedApp) setStopped(exit string) {
+	a.mu.Lock()
+	a.state = appStopped
+	a.cmd = nil
+	a.lastExit = exit
+	a.mu.Unlock()
+}
+
+// shutdown stops supervising and terminates the process, waiting for it
+// to exit
+func (a *managedApp) shutdown() {
+	if a.stopCh == nil {
+		return
+	}
+	select {
+	case <-a.stopCh:
+	default:
+		close(a.stopCh)
+	}
+	<-a.done
+}
+
+func (a *managedApp) status() gin.H {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	status := gin.H{
+		"name":      a.spec.Name,
+		"state":     a.state,
+		"port":      a.spec.Port,
+		"restarts":  a.restarts,
+		"last_exit": a.lastExit,
+	}
+	if a.cmd != nil && a.cmd.Process != nil {
+		status["pid"] = a.cmd.Process.Pid
+		status["started_at"] = a.startedAt
+	}
+	if a.spec.HealthCheck != nil {
+		status["healthy"] = a.healthy
+		if a.lastProbe != "" {
+			status["last_probe_error"] = a.lastProbe
+		}
+	}
+	return status
+}
+
+// Starts a stopped app again
+func startAppHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+	if err := apps.restart(liveInstance(p.Name)); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	status, _ := apps.status(liveInstance(p.Name))
+	c.JSON(http.StatusOK, status)
+}
+
+func stopAppHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+	if err := apps.stop(liveInstance(p.Name)); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	status, _ := apps.status(liveInstance(p.Name))
+	c.JSON(http.StatusOK, status)
+}
+
+func restartAppHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+	if err := apps.restart(liveInstance(p.Name)); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	status, _ := apps.status(liveInstance(p.Name))
+	c.JSON(http.StatusOK, status)
+}
+
+func appStatusHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+	status, ok := apps.status(liveInstance(p.Name))
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "app not found"})
+		return
+	}
+	c.JSON(http.StatusOK, status)
+}
+
+// Lists the apps of the logged in user's projects
+func listAppsHandler(c *gin.Context) {
+	login, ok := requireLogin(c)
+	if !ok {
+		return
+	}
+	projects, err := db.Project.Query().Where(project.Owner(login)).Select(project.FieldName).Strings(dbCtx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not load projects"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"apps": apps.list(projects)})
+}
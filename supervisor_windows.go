@@ -0,0 +1,18 @@
+//go:build windows
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"time"
+)
+
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// terminateProcess kills the process, Windows has no SIGTERM to wait on
+func terminateProcess(pid int, timeout time.Duration) {
+	if p, err := os.FindProcess(pid); err == nil {
+		p.Kill()
+	}
+}
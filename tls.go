@@ -0,0 +1,290 @@
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/RajBhut/go-basics/caddy"
+	"github.com/RajBhut/go-basics/ent/domain"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	letsEncryptDirectory = "https://acme-v02.api.letsencrypt.org/directory"
+	localhostPolicyID    = "hoster-tls-localhost"
+	certExpiryWarning    = 14 * 24 * time.Hour
+)
+
+// tlsSettings say how certificates for verified custom domains are issued
+type tlsSettings struct {
+	// Issuer is "acme", "internal" for Caddy's local CA, or "off" to leave
+	// Caddy's TLS config alone
+	Issuer string
+	// CA is the ACME directory, e.g. Pebble's https://localhost:14000/dir
+	CA     string
+	Email  string
+	CARoot string
+	// HTTPSAddr is where Caddy terminates TLS, certificates are checked there
+	HTTPSAddr string
+}
+
+// tlsSettingsFromEnv reads HOSTER_TLS_ISSUER, HOSTER_ACME_CA,
+// HOSTER_ACME_EMAIL, HOSTER_ACME_CA_ROOT (the PEM root of a CA that isn't
+// publicly trusted) and HOSTER_HTTPS_ADDR
+func tlsSettingsFromEnv() tlsSettings {
+	settings := tlsSettings{
+		Issuer:    strings.ToLower(envOr("HOSTER_TLS_ISSUER", "acme")),
+		CA:        envOr("HOSTER_ACME_CA", letsEncryptDirectory),
+		Email:     os.Getenv("HOSTER_ACME_EMAIL"),
+		CARoot:    os.Getenv("HOSTER_ACME_CA_ROOT"),
+		HTTPSAddr: envOr("HOSTER_HTTPS_ADDR", "localhost:443"),
+	}
+	switch settings.Issuer {
+	case "acme", "internal", "off":
+	default:
+		fmt.Printf("Warning: Unknown HOSTER_TLS_ISSUER %q, using acme\n", settings.Issuer)
+		settings.Issuer = "acme"
+	}
+	return settings
+}
+
+func (s tlsSettings) enabled() bool {
+	return s.Issuer != "off"
+}
+
+func (s tlsSettings) issuer() caddy.Issuer {
+	if s.Issuer == "internal" {
+		return caddy.InternalIssuer()
+	}
+	return caddy.ACMEIssuer(s.CA, s.Email, s.CARoot)
+}
+
+// listeners are the addresses our server has to listen on: HTTP for the
+// generated hosts and ACME challenges, HTTPS for everything else
+func (s tlsSettings) listeners() []string {
+	https := ":443"
+	if _, port, err := net.SplitHostPort(s.HTTPSAddr); err == nil {
+		https = ":" + port
+	}
+	return []string{":80", https}
+}
+
+func tlsPolicyID(hostname string) string {
+	return "hoster-tls-" + hostname
+}
+
+// domainPolicy issues the certificate of a verified custom domain
+func domainPolicy(hostname string) caddy.AutomationPolicy {
+	return caddy.AutomationPolicy{
+		ID:       tlsPolicyID(hostname),
+		Subjects: []string{hostname},
+		Issuers:  []caddy.Issuer{tlsOptions.issuer()},
+	}
+}
+
+// localhostPolicy keeps generated hosts on Caddy's internal CA whatever
+// issuer custom domains use
+func localhostPolicy() caddy.AutomationPolicy {
+	return caddy.AutomationPolicy{
+		ID:       localhostPolicyID,
+		Subjects: []string{projectHost("*")},
+		Issuers:  []caddy.Issuer{caddy.InternalIssuer()},
+	}
+}
+
+// desiredPolicies returns the automation policy of every verified domain
+// and of the generated hosts, keyed by @id
+func desiredPolicies() (map[string]caddy.AutomationPolicy, error) {
+	if !tlsOptions.enabled() {
+		return map[string]caddy.AutomationPolicy{}, nil
+	}
+	hosts, err := db.Domain.Query().Where(domain.Verified(true)).Select(domain.FieldHostname).Strings(dbCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list domains: %v", err)
+	}
+	return policiesFor(hosts), nil
+}
+
+// policiesFor returns the policies for the verified domains hosts. There
+// are none without a verified domain, so Caddy keeps serving plain HTTP.
+func policiesFor(hosts []string) map[string]caddy.AutomationPolicy {
+	desired := map[string]caddy.AutomationPolicy{}
+	if len(hosts) == 0 {
+		return desired
+	}
+	desired[localhostPolicyID] = localhostPolicy()
+	for _, host := range hosts {
+		desired[tlsPolicyID(host)] = domainPolicy(host)
+	}
+	return desired
+}
+
+// ensureHTTPS makes our server listen for HTTPS with certificates managed
+// but without redirects, so http:// URLs keep working. It returns whether
+// anything changed and must be called with caddyMu held.
+func ensureHTTPS(server *caddy.Server) (bool, error) {
+	changed := false
+	listen := server.Listen
+	for _, addr := range tlsOptions.listeners() {
+		if !slices.Contains(listen, addr) {
+			listen = append(listen, addr)
+		}
+	}
+	if len(listen) != len(server.Listen) {
+		if err := caddyAdmin.SetListen(listen); err != nil {
+			return false, fmt.Errorf("failed to update Caddy listeners: %v", err)
+		}
+		changed = true
+	}
+	if a := server.AutomaticHTTPS; a == nil || a.Disable || !a.DisableRedirects {
+		if err := caddyAdmin.SetAutomaticHTTPS(caddy.AutomaticHTTPS{DisableRedirects: true}); err != nil {
+			return false, fmt.Errorf("failed to enable automatic HTTPS: %v", err)
+		}
+		changed = true
+	}
+	return changed, nil
+}
+
+// setTLSPolicy has Caddy obtain a certificate for a verified domain
+func setTLSPolicy(hostname string) error {
+	if !tlsOptions.enabled() {
+		return nil
+	}
+	caddyMu.Lock()
+	defer caddyMu.Unlock()
+
+	config, _, err := readCaddyConfig()
+	if err != nil {
+		return err
+	}
+	server := config.Server(caddyAdmin.Server)
+	if server == nil {
+		return fmt.Errorf("Caddy has no %s server", caddyAdmin.Server)
+	}
+	if _, err := ensureHTTPS(server); err != nil {
+		return err
+	}
+	for _, policy := range []caddy.AutomationPolicy{localhostPolicy(), domainPolicy(hostname)} {
+		if err := caddyAdmin.UpsertPolicy(policy); err != nil {
+			return fmt.Errorf("failed to update TLS policy: %v", err)
+		}
+	}
+	saveCaddySnapshot()
+	return nil
+}
+
+func removeTLSPolicy(hostname string) error {
+	caddyMu.Lock()
+	defer caddyMu.Unlock()
+	if err := caddyAdmin.RemovePolicy(tlsPolicyID(hostname)); err != nil {
+		return fmt.Errorf("failed to remove TLS policy: %v", err)
+	}
+	saveCaddySnapshot()
+	return nil
+}
+
+// certificateStatus reports the certificate Caddy serves for hostname. It
+// is "pending" until Caddy has one, then "active", "expiring", "expired"
+// or "mismatch" when it doesn't cover the hostname. "unreachable" means
+// nothing listens on HOSTER_HTTPS_ADDR.
+func certificateStatus(hostname string) gin.H {
+	if !tlsOptions.enabled() {
+		return gin.H{"status": "disabled"}
+	}
+	raw, err := net.DialTimeout("tcp", tlsOptions.HTTPSAddr, 3*time.Second)
+	if err != nil {
+		return gin.H{"status": "unreachable", "error": err.Error()}
+	}
+	defer raw.Close()
+	raw.SetDeadline(time.Now().Add(3 * time.Second))
+
+	// Only the certificate is inspected, a test CA is fine. Caddy fails the
+	// handshake while it has no certificate for the name.
+	conn := tls.Client(raw, &tls.Config{ServerName: hostname, InsecureSkipVerify: true})
+	if err := conn.Handshake(); err != nil {
+		return gin.H{"status": "pending", "error": err.Error()}
+	}
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return gin.H{"status": "pending"}
+	}
+
+	leaf := certs[0]
+	status := "active"
+	switch {
+	case leaf.VerifyHostname(hostname) != nil:
+		status = "mismatch"
+	case time.Now().After(leaf.NotAfter):
+		status = "expired"
+	case time.Until(leaf.NotAfter) < certExpiryWarning:
+		status = "expiring"
+	}
+	return gin.H{
+		"status":     status,
+		"issuer":     leaf.Issuer.String(),
+		"not_before": leaf.NotBefore,
+		"not_after":  leaf.NotAfter,
+	}
+}
+
+// applyPolicies makes Caddy's TLS policies match desired and opens the
+// server for HTTPS when there are any. Policies without our @id prefix are
+// left alone. It must be called with caddyMu held.
+func applyPolicies(config *caddy.Config, desired map[string]caddy.AutomationPolicy, report *driftReport) error {
+	if len(desired) > 0 {
+		enabled, err := ensureHTTPS(config.Server(caddyAdmin.Server))
+		if err != nil {
+			return err
+		}
+		report.HTTPSEnabled = enabled
+	}
+
+	live := map[string]caddy.AutomationPolicy{}
+	for _, policy := range config.Policies() {
+		if strings.HasPrefix(policy.ID, tlsPolicyID("")) {
+			live[policy.ID] = policy
+		}
+	}
+
+	ids := make([]string, 0, len(desired))
+	for id := range desired {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		policy := desired[id]
+		if current, ok := live[id]; ok && samePolicy(current, policy) {
+			continue
+		}
+		if err := caddyAdmin.UpsertPolicy(policy); err != nil {
+			return fmt.Errorf("failed to update TLS policy %s: %v", id, err)
+		}
+		report.TLSUpdated = append(report.TLSUpdated, policy.Subjects...)
+	}
+
+	for id, policy := range live {
+		if _, ok := desired[id]; ok {
+			continue
+		}
+		if err := caddyAdmin.RemovePolicy(id); err != nil {
+			return fmt.Errorf("failed to remove TLS policy %s: %v", id, err)
+		}
+		report.TLSRemoved = append(report.TLSRemoved, policy.Subjects...)
+	}
+	sort.Strings(report.TLSRemoved)
+	return nil
+}
+
+func samePolicy(a, b caddy.AutomationPolicy) bool {
+	x, _ := json.Marshal(a)
+	y, _ := json.Marshal(b)
+	return bytes.Equal(x, y)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/RajBhut/go-basics/caddy"
+)
+
+func setupTLSTest(t *testing.T) {
+	t.Helper()
+	setupCaddyTest(t)
+	old := tlsOptions
+	t.Cleanup(func() { tlsOptions = old })
+	tlsOptions = tlsSettings{Issuer: "acme", CA: letsEncryptDirectory, HTTPSAddr: "localhost:443"}
+}
+
+func TestApplyPoliciesWithoutVerifiedDomains(t *testing.T) {
+	setupTLSTest(t)
+	// Left over from a domain that was removed while Hoster was down
+	for _, policy := range []caddy.AutomationPolicy{localhostPolicy(), domainPolicy("old.example.com")} {
+		if err := caddyAdmin.UpsertPolicy(policy); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	config, _, err := readCaddyConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var report driftReport
+	if err := applyPolicies(config, policiesFor(nil), &report); err != nil {
+		t.Fatalf("applyPolicies: %v", err)
+	}
+
+	config, _, err = readCaddyConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := config.Server(caddyAdmin.Server)
+	if !slices.Equal(server.Listen, []string{":80"}) || server.AutomaticHTTPS != nil || report.HTTPSEnabled {
+		t.Errorf("server = %+v, want it left on plain HTTP", server)
+	}
+	if policies := config.Policies(); len(policies) != 0 {
+		t.Errorf("policies = %+v, want none", policies)
+	}
+	if !slices.Equal(report.TLSRemoved, []string{"*.hoster.localhost", "old.example.com"}) {
+		t.Errorf("TLSRemoved = %v", report.TLSRemoved)
+	}
+}
+
+func TestApplyPoliciesWithVerifiedDomain(t *testing.T) {
+	setupTLSTest(t)
+
+	config, _, err := readCaddyConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var report driftReport
+	if err := applyPolicies(config, policiesFor([]string{"shop.example.com"}), &report); err != nil {
+		t.Fatalf("applyPolicies: %v", err)
+	}
+
+	config, _, err = readCaddyConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := config.Server(caddyAdmin.Server)
+	if !slices.Equal(server.Listen, []string{":80", ":443"}) || !report.HTTPSEnabled {
+		t.Errorf("listen = %v, want HTTP kept next to HTTPS", server.Listen)
+	}
+	if a := server.AutomaticHTTPS; a == nil || a.Disable || !a.DisableRedirects {
+		t.Errorf("automatic_https = %+v, want certificates without redirects", a)
+	}
+	if len(config.Policies()) != 2 {
+		t.Errorf("policies = %+v, want the domain's and the generated hosts'", config.Policies())
+	}
+}
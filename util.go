@@ -2,13 +2,15 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"runtime"
+	"regexp"
 	"strings"
 	"time"
 
@@ -208,6 +210,15 @@ func getRepoDetailsHandler(c *gin.Context) {
 	})
 }
 
+// repoNamePattern matches the names GitHub allows for repositories
+var repoNamePattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,100}$`)
+
+// isRepoName reports whether name is a valid GitHub repository name. The
+// name ends up in paths and hostnames, so nothing else is accepted.
+func isRepoName(name string) bool {
+	return repoNamePattern.MatchString(name) && name != "." && name != ".."
+}
+
 // Deployment handlers
 func selectRepoHandler(c *gin.Context) {
 	tokenCookie, err := c.Cookie("access_token")
@@ -218,11 +229,20 @@ func selectRepoHandler(c *gin.Context) {
 
 	var requestBody struct {
 		RepoName string `json:"repo_name" binding:"required"`
+		Ref      string `json:"ref"`
 	}
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
 		return
 	}
+	if !isRepoName(requestBody.RepoName) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid repo_name"})
+		return
+	}
+	if strings.HasPrefix(requestBody.Ref, "-") {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid ref"})
+		return
+	}
 
 	// Setup GitHub client with user token
 	token := &oauth2.Token{AccessToken: tokenCookie}
@@ -235,23 +255,193 @@ func selectRepoHandler(c *gin.Context) {
 		return
 	}
 
-	// Clone and deploy
-	deploymentURL, err := cloneAndDeployRepo(user.GetLogin(), requestBody.RepoName, tokenCookie)
+	// Queue the deployment and return immediately
+	job := newDeployJob(user.GetLogin(), requestBody.RepoName, requestBody.Ref, tokenCookie)
+	err = startDeployment(job)
+	if errors.Is(err, errProjectTaken) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message":       "Deployment queued",
+		"deployment_id": job.ID,
+		"status":        statusQueued,
+		"status_url":    fmt.Sprintf("/deployments/%s", job.ID),
+	})
+}
+
+// Records a new deployment and hands it to the worker pool
+func startDeployment(job *deployJob) error {
+	if err := createDeploymentRecord(job); err != nil {
+		return err
+	}
+	if err := deployQueue.enqueue(job); err != nil {
+		job.finish("", err)
+		return err
+	}
+	return nil
+}
+
+// Reports the current state of a queued or finished deployment
+func deploymentStatusHandler(c *gin.Context) {
+	if !requireDeploymentOwner(c) {
+		return
+	}
+	if job, ok := deployQueue.get(c.Param("id")); ok {
+		c.JSON(http.StatusOK, job.snapshot())
+		return
+	}
+
+	// Older deployments are only known to the database
+	record, err := db.Deployment.Get(dbCtx, c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(http.StatusNotFound, gin.H{"error": "deployment not found"})
 		return
 	}
+	c.JSON(http.StatusOK, deploymentRecordJSON(record))
+}
+
+// Streams a deployment's clone/install/build output as Server-Sent Events.
+// Running deployments are followed live, finished ones are replayed from disk.
+func deploymentLogsHandler(c *gin.Context) {
+	if !requireDeploymentOwner(c) {
+		return
+	}
+	streamDeploymentLogs(c, c.Param("id"))
+}
+
+// streamDeploymentLogs follows the log of a running deployment. A reader
+// too slow to keep up is dropped by the log and resumes from the last line
+// it got, from disk once the deployment has finished.
+func streamDeploymentLogs(c *gin.Context, id string) {
+	var backlog []string
+	var ch chan string
+	job, ok := deployQueue.get(id)
+	if ok {
+		backlog, ch, ok = job.log.subscribe(0)
+	}
+	if !ok {
+		lines, err := readDeploymentLog(id)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "logs not found"})
+			return
+		}
+		for _, line := range lines {
+			c.SSEvent("log", line)
+		}
+		c.SSEvent("end", "")
+		return
+	}
+	defer func() { job.log.unsubscribe(ch) }()
+
+	sent := 0
+	send := func(lines []string) {
+		for _, line := range lines {
+			c.SSEvent("log", line)
+		}
+		sent += len(lines)
+	}
+	send(backlog)
+	c.Writer.Flush()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case line, ok := <-ch:
+			if ok {
+				send([]string{line})
+				return true
+			}
+			if backlog, ch, ok = job.log.subscribe(sent); ok {
+				send(backlog)
+				return true
+			}
+			// Lines logged after the reader fell behind are only on disk
+			if lines, err := readDeploymentLog(id); err == nil && sent < len(lines) {
+				send(lines[sent:])
+			}
+			c.SSEvent("end", job.currentStatus())
+			return false
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+// Sets the branch whose pushes redeploy a project
+func setProjectBranchHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+
+	var requestBody struct {
+		Branch string `json:"branch" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	if err := p.Update().SetBranch(requestBody.Branch).Exec(dbCtx); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not update branch"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Branch updated", "branch": requestBody.Branch})
+}
+
+// Re-points a project to an earlier release. Without a body it goes back
+// one release.
+func rollbackHandler(c *gin.Context) {
+	p, ok := requireProjectOwner(c)
+	if !ok {
+		return
+	}
+
+	var requestBody struct {
+		Release string `json:"release"`
+	}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&requestBody); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+			return
+		}
+	}
+
+	releaseID, err := rollbackRelease(p.Name, requestBody.Release)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	setCurrentReleaseRecord(p.Name, releaseID)
 
 	c.JSON(http.StatusOK, gin.H{
-		"message":    "Repository deployed successfully",
-		"deploy_url": deploymentURL,
+		"message": "Rolled back successfully",
+		"release": releaseID,
 	})
 }
 
 // Clones and deploys a repository
-func cloneAndDeployRepo(owner, repoName, accessToken string) (string, error) {
-	// Create a unique deployment ID
-	deploymentID := fmt.Sprintf("%s-%d", repoName, time.Now().Unix())
+func cloneAndDeployRepo(job *deployJob) (string, error) {
+	deploymentID := job.ID
+
+	if job.Fork {
+		// Checked again here in case the builder changed since the webhook
+		if !isolatedBuilds() {
+			return "", fmt.Errorf("previews of forks need HOSTER_BUILDER=container")
+		}
+		job.log.Printf("Pull request from a fork, project variables are withheld\n")
+	} else {
+		env, err := projectEnv(job.Project, job.Environment)
+		if err != nil {
+			return "", fmt.Errorf("failed to load environment variables: %v", err)
+		}
+		job.env = env
+	}
 
 	// Create directories
 	baseDir := filepath.Join(deploymentRootDir, deploymentID)
@@ -260,61 +450,168 @@ func cloneAndDeployRepo(owner, repoName, accessToken string) (string, error) {
 	}
 
 	// Clone the repository using Git CLI
-	repoURL := fmt.Sprintf("https://%s@github.com/%s/%s.git", accessToken, owner, repoName)
-	cloneCmd := exec.Command("git", "clone", repoURL, baseDir)
-	fmt.Printf("Cloning repository: %s to %s\n", repoName, baseDir)
+	job.setStatus(statusCloning)
+	repoURL := fmt.Sprintf("https://github.com/%s/%s.git", job.Owner, job.Repo)
+	cloneCmd := job.gitCommand("", "clone", repoURL, baseDir)
+	job.log.Printf("Cloning repository: %s to %s\n", job.Repo, baseDir)
 
-	if err := cloneCmd.Run(); err != nil {
+	if err := job.run(cloneCmd); err != nil {
 		return "", fmt.Errorf("failed to clone repository: %v", err)
 	}
 
-	fmt.Printf("Repository cloned successfully to: %s\n", baseDir)
+	job.log.Printf("Repository cloned successfully to: %s\n", baseDir)
+
+	// Check out the requested branch, tag or commit
+	if job.Ref != "" {
+		checkoutRef := job.Ref
+		if strings.HasPrefix(job.Ref, "refs/pull/") {
+			// Pull request heads, including ones from forks, have to be fetched
+			job.log.Printf("Fetching %s\n", job.Ref)
+			fetchCmd := job.gitCommand(baseDir, "fetch", "origin", job.Ref)
+			if err := job.run(fetchCmd); err != nil {
+				return "", fmt.Errorf("failed to fetch %s: %v", job.Ref, err)
+			}
+			checkoutRef = "FETCH_HEAD"
+		}
 
-	deployURL, err := deployBasedOnType(baseDir, deploymentID)
+		job.log.Printf("Checking out %s\n", job.Ref)
+		checkoutCmd := exec.Command("git", "checkout", checkoutRef)
+		checkoutCmd.Dir = baseDir
+		if err := job.run(checkoutCmd); err != nil {
+			return "", fmt.Errorf("failed to check out %s: %v", job.Ref, err)
+		}
+	}
+
+	sha, err := resolveCommitSHA(baseDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve commit: %v", err)
+	}
+	job.setCommitSHA(sha)
+	job.log.Printf("Deploying commit %s\n", sha)
+
+	deployURL, err := deployBasedOnType(job, baseDir)
 	if err != nil {
 		return "", fmt.Errorf("deployment failed: %v", err)
 	}
 
+	if job.Environment == environmentPreview {
+		return publishPreview(job)
+	}
+
 	return deployURL, nil
 }
 
+// gitCommand runs git in dir with the job's token sent as an auth header.
+// It is passed through the environment so it never ends up in .git/config
+// of a workspace that untrusted build steps can read.
+func (j *deployJob) gitCommand(dir string, args ...string) *exec.Cmd {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if j.Token != "" {
+		credentials := base64.StdEncoding.EncodeToString([]byte("x-access-token:" + j.Token))
+		cmd.Env = append(os.Environ(),
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http.https://github.com/.extraheader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+credentials,
+		)
+	}
+	return cmd
+}
+
+// Returns the commit SHA checked out in repoDir
+func resolveCommitSHA(repoDir string) (string, error) {
+	cmd := exec.Command("git", "rev-parse", "HEAD")
+	cmd.Dir = repoDir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
 // Identifies repository type and runs appropriate deployment
-func deployBasedOnType(repoDir, deploymentID string) (string, error) {
-	// First check at the root level and search for nested projects
-	projectDir, projectType := findProjectRoot(repoDir)
+func deployBasedOnType(job *deployJob, repoDir string) (string, error) {
+	manifest, err := loadManifest(repoDir)
+	if err != nil {
+		return "", err
+	}
 
-	fmt.Println("Project directory found:", projectDir)
-	fmt.Println("Project type:", projectType)
+	var projectDir, projectType string
+	if manifest != nil {
+		job.log.Printf("Using project manifest\n")
+		projectDir = filepath.Join(repoDir, filepath.Clean(manifest.Root))
+		projectType = manifest.Type
+		if projectType == "" {
+			projectType = detectProjectType(projectDir)
+		}
+		// Project variables take precedence over the manifest's
+		job.env = append(manifest.envList(), job.env...)
+	} else {
+		// First check at the root level and search for nested projects
+		projectDir, projectType = findProjectRoot(repoDir)
+		manifest = &Manifest{}
+	}
+	job.manifest = manifest
+
+	if projectType == "" || projectType == "node" || projectType == "static" {
+		if preset, ok := detectFramework(projectDir); ok {
+			job.log.Printf("Detected framework: %s\n", preset.Framework)
+			job.preset = preset
+			if manifest.Type == "" {
+				projectType = preset.Type
+			}
+			job.env = append(append([]string{}, preset.Env...), job.env...)
+		}
+	}
+
+	job.log.Printf("Project directory found: %s\n", projectDir)
+	job.log.Printf("Project type: %s\n", projectType)
+	job.setProjectType(projectType)
 
 	switch projectType {
 	case "node":
-		return deployNodeApp(projectDir, deploymentID)
+		return deployNodeApp(job, projectDir)
 	case "go":
-		return deployGoApp(projectDir, deploymentID)
+		return deployGoApp(job, projectDir)
 	case "python":
-		return deployPythonApp(projectDir, deploymentID)
+		return deployPythonApp(job, projectDir)
 	case "static":
-		return deployStaticSite(projectDir, deploymentID)
+		return deployStaticSite(job, projectDir)
+	case "docker":
+		return deployDockerApp(job, projectDir)
 	default:
 		return "", fmt.Errorf("unsupported repository type")
 	}
 }
 
+// detectProjectType returns the type of the project in dir, empty if it
+// is not recognized
+func detectProjectType(dir string) string {
+	switch {
+	case isNodeProject(dir):
+		return "node"
+	case isGoProject(dir):
+		return "go"
+	case isPythonProject(dir):
+		return "python"
+	}
+	if preset, ok := detectFramework(dir); ok {
+		return preset.Type
+	}
+	// Projects that ship a Dockerfile for local development keep deploying
+	// as before, "type: docker" in the manifest builds the image instead
+	if isDockerProject(dir) {
+		return "docker"
+	}
+	return ""
+}
+
 // findProjectRoot searches for project files in the repository
 // and returns the project directory and type
 func findProjectRoot(rootDir string) (string, string) {
 	// First check the root directory
-	if isNodeProject(rootDir) {
-		return rootDir, "node"
-	}
-	if isGoProject(rootDir) {
-		return rootDir, "go"
-	}
-	if isPythonProject(rootDir) {
-		return rootDir, "python"
-	}
-	if isStaticSite(rootDir) {
-		return rootDir, "static"
+	if projectType := detectProjectType(rootDir); projectType != "" {
+		return rootDir, projectType
 	}
 
 	// If not found at root, search one level deeper
@@ -329,21 +626,12 @@ func findProjectRoot(rootDir string) (string, string) {
 
 			// Skip common non-project directories
 			if entry.Name() == "node_modules" || entry.Name() == ".git" ||
-				entry.Name() == "venv" || entry.Name() == ".github" {
+				entry.Name() == "venv" || entry.Name() == pythonVenv || entry.Name() == ".github" {
 				continue
 			}
 
-			if isNodeProject(subDir) {
-				return subDir, "node"
-			}
-			if isGoProject(subDir) {
-				return subDir, "go"
-			}
-			if isPythonProject(subDir) {
-				return subDir, "python"
-			}
-			if isStaticSite(subDir) {
-				return subDir, "static"
+			if projectType := detectProjectType(subDir); projectType != "" {
+				return subDir, projectType
 			}
 		}
 	}
@@ -364,13 +652,12 @@ func isGoProject(dir string) bool {
 }
 
 func isPythonProject(dir string) bool {
-	_, err := os.Stat(filepath.Join(dir, "requirements.txt"))
-	return err == nil
-}
-
-func isStaticSite(dir string) bool {
-	_, err := os.Stat(filepath.Join(dir, "index.html"))
-	return err == nil
+	for _, name := range []string{"requirements.txt", "pyproject.toml", "Pipfile"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
 }
 
 // Helper function to copy directories
@@ -390,9 +677,14 @@ func copyDirectory(source, target string) error {
 		sourcePath := filepath.Join(source, entry.Name())
 		targetPath := filepath.Join(target, entry.Name())
 
+		// A symlink could point anywhere on the server, e.g. at its .env
+		if !entry.IsDir() && !entry.Type().IsRegular() {
+			fmt.Printf("Warning: Skipping %s, only regular files are published\n", sourcePath)
+			continue
+		}
 		if entry.IsDir() {
 			// Skip node_modules to avoid large copies
-			if entry.Name() == "node_modules" || entry.Name() == ".git" {
+			if entry.Name() == "node_modules" || entry.Name() == ".git" || entry.Name() == workspaceCacheDir {
 				continue
 			}
 			// Recursively copy subdirectories
@@ -415,61 +707,20 @@ func copyDirectory(source, target string) error {
 	return nil
 }
 
-// Move project to permanent storage
-func add_to_deployed_folder(sourceDir, projectName string) error {
-	cleanProjectName := strings.Split(projectName, "-")[0]
-
-	deployedDir := "Deployed"
-	if err := os.MkdirAll(deployedDir, 0755); err != nil {
-		return fmt.Errorf("failed to create Deployed directory: %v", err)
-	}
-
-	projectDir := filepath.Join(deployedDir, cleanProjectName)
-
-	if _, err := os.Stat(projectDir); err == nil {
-		if err := os.RemoveAll(projectDir); err != nil {
-			return fmt.Errorf("failed to clean existing project directory: %v", err)
-		}
-	}
-
-	if err := os.MkdirAll(projectDir, 0755); err != nil {
-		return fmt.Errorf("failed to create project directory: %v", err)
-	}
-
-	// Check common build directories
-	buildDirs := []string{"dist", "build", "public", "out", "_site"}
-	var buildDir string
-	var sourceBuildDir string
-
-	for _, dir := range buildDirs {
-		path := filepath.Join(sourceDir, dir)
-		if info, err := os.Stat(path); err == nil && info.IsDir() {
-			buildDir = dir
-			sourceBuildDir = path
-			fmt.Printf("Found build directory: %s\n", buildDir)
-			break
-		}
-	}
-
+// Move project to permanent storage. buildDir is relative to sourceDir,
+// empty to publish sourceDir itself.
+func add_to_deployed_folder(sourceDir, buildDir, projectName, releaseID string) error {
+	releaseSource := filepath.Join(sourceDir, buildDir)
 	if buildDir == "" {
 		fmt.Println("No specific build directory found, copying entire source directory")
-		if err := copyDirectory(sourceDir, projectDir); err != nil {
-			return fmt.Errorf("failed to copy source directory: %v", err)
-		}
-	} else {
-		// Create the build directory in the project directory
-		targetDir := filepath.Join(projectDir, buildDir)
-		if err := os.MkdirAll(targetDir, 0755); err != nil {
-			return fmt.Errorf("failed to create build directory: %v", err)
-		}
+	}
 
-		// Copy build files
-		if err := copyDirectory(sourceBuildDir, targetDir); err != nil {
-			return fmt.Errorf("failed to copy build directory: %v", err)
-		}
+	// The previous release keeps serving until the new one is fully copied
+	if err := publishRelease(projectName, releaseID, releaseSource); err != nil {
+		return err
 	}
 
-	fmt.Printf("Successfully copied from %s to %s\n", sourceDir, projectDir)
+	fmt.Printf("Successfully released %s as %s/%s\n", sourceDir, projectName, releaseID)
 
 	// Remove the original deployment directory after a delay
 	// to ensure ongoing requests can complete
@@ -486,182 +737,352 @@ func add_to_deployed_folder(sourceDir, projectName string) error {
 	return nil
 }
 
-// Function to update package.json homepage for React/Vite projects
-func updateHomepage(projectPath, projectName string) error {
-	pkgFile := filepath.Join(projectPath, "package.json")
-	data, err := os.ReadFile(pkgFile)
-	if err != nil {
-		return err
+// Deployment function for Node.js apps (React/Vite/Next.js)
+func deployNodeApp(job *deployJob, repoDir string) (string, error) {
+	// An explicit runtime in the manifest wins over engines.node
+	if job.preset.NodeVersion != "" && job.manifest.Runtime == "" {
+		if len(installedVersions("node")) == 0 {
+			job.log.Printf("Warning: No Node.js versions installed in HOSTER_TOOLCHAINS_DIR, ignoring engines.node %q\n", job.preset.NodeVersion)
+		} else {
+			version, err := resolveToolchainVersion("node", job.preset.NodeVersion)
+			if err != nil {
+				return "", fmt.Errorf("engines.node: %v", err)
+			}
+			job.log.Printf("Using Node.js %s for engines.node %q\n", version, job.preset.NodeVersion)
+			job.nodeVersion = version
+		}
 	}
 
-	var pkg map[string]interface{}
-	if err := json.Unmarshal(data, &pkg); err != nil {
-		return err
-	}
+	build := func() error {
+		// Install dependencies
+		job.setStatus(statusInstalling)
+		if job.preset.PackageManager != "" {
+			job.log.Printf("Installing dependencies with %s...\n", job.preset.PackageManager)
+		}
+		err := job.withBuildCache(repoDir, "node", func() error {
+			return job.buildStep(repoDir, job.toolchain(), job.manifest.Install, job.preset.Install...)
+		})
+		if err != nil {
+			return fmt.Errorf("install failed: %v", err)
+		}
 
-	// Set homepage to match our serving path
-	cleanProjectName := strings.Split(projectName, "-")[0]
-	pkg["homepage"] = "/projects/" + cleanProjectName
-
-	// Update Vite configuration if needed
-	if deps, ok := pkg["dependencies"].(map[string]interface{}); ok {
-		if _, hasVite := deps["vite"]; hasVite {
-			// Create or update vite.config.js
-			viteConfigPath := filepath.Join(projectPath, "vite.config.js")
-			viteConfig := fmt.Sprintf(`
-import { defineConfig } from 'vite'
-import react from '@vitejs/plugin-react'
-
-export default defineConfig({
-  plugins: [react()],
-  base: '/projects/%s/',
-})
-`, cleanProjectName)
-
-			if err := os.WriteFile(viteConfigPath, []byte(viteConfig), 0644); err != nil {
-				fmt.Printf("Warning: Failed to create Vite config: %v\n", err)
-			} else {
-				fmt.Println("Updated Vite configuration")
-			}
+		// Build the project
+		job.setStatus(statusBuilding)
+		job.log.Printf("Building project...\n")
+		if err := job.buildStep(repoDir, job.toolchain(), job.manifest.Build, job.preset.Build...); err != nil {
+			return fmt.Errorf("build failed: %v", err)
+		}
+		return nil
+	}
+	// Builds of a declared output directory can be reused, servers need
+	// their dependencies installed
+	if output := job.staticOutput(); output != "" {
+		err := job.withArtifactCache(filepath.Join(repoDir, output), job.staticBuildInputs(output), build)
+		if err != nil {
+			return "", err
 		}
+	} else if err := build(); err != nil {
+		return "", err
+	}
+
+	// Server rendered apps run under the supervisor instead
+	if job.manifest.Start != "" {
+		job.setStatus(statusPublishing)
+		return startSupervisedApp(job, repoDir, "sh", "-c", job.manifest.Start)
+	}
+	if len(job.preset.Start) > 0 {
+		job.setStatus(statusPublishing)
+		return startSupervisedApp(job, repoDir, job.preset.Start...)
 	}
 
-	updated, err := json.MarshalIndent(pkg, "", "  ")
+	buildDir, err := findBuildDir(job, repoDir)
 	if err != nil {
-		return err
+		return "", err
 	}
+	job.setBuildDir(buildDir)
 
-	return os.WriteFile(pkgFile, updated, 0644)
+	// Move files to Deployed folder and clean up
+	job.setStatus(statusPublishing)
+	if err := add_to_deployed_folder(repoDir, buildDir, job.siteName(), job.ID); err != nil {
+		return "", fmt.Errorf("failed to publish release: %v", err)
+	}
+	if err := applySiteSettings(job.siteName(), siteSettings{SPA: job.manifest.spaFallback()}); err != nil {
+		job.log.Printf("Warning: %v\n", err)
+	}
+
+	// Return the URL where the project will be accessible
+	return fmt.Sprintf("http://localhost:8000/projects/%s", job.siteName()), nil
 }
 
-// Deployment function for Node.js apps (React/Vite/Next.js)
-func deployNodeApp(repoDir, deploymentID string) (string, error) {
-	// Extract the project name from the deployment ID
-	projectName := filepath.Base(repoDir)
-	cleanProjectName := strings.Split(projectName, "-")[0]
-
-	// Update package.json with homepage
-	if err := updateHomepage(repoDir, deploymentID); err != nil {
-		fmt.Printf("Warning: Could not update homepage in package.json: %v\n", err)
-	} else {
-		fmt.Println("Updated homepage in package.json")
+// findBuildDir returns the build output directory relative to repoDir,
+// taken from the manifest or the preset or guessed from common names
+func findBuildDir(job *deployJob, repoDir string) (string, error) {
+	output := job.manifest.Output
+	if output == "" {
+		output = job.preset.Output
 	}
-
-	// Install dependencies
-	installCmd := exec.Command("npm", "install")
-	installCmd.Dir = repoDir
-	fmt.Println("Installing npm dependencies...")
-	if err := installCmd.Run(); err != nil {
-		return "", fmt.Errorf("npm install failed: %v", err)
+	if output != "" {
+		buildDir := filepath.Clean(output)
+		if buildDir == "." {
+			return "", nil
+		}
+		if info, err := os.Stat(filepath.Join(repoDir, buildDir)); err != nil || !info.IsDir() {
+			return "", fmt.Errorf("output directory %s was not created by the build", buildDir)
+		}
+		return buildDir, nil
 	}
 
-	// Build the project
-	buildCmd := exec.Command("npm", "run", "build")
-	buildCmd.Dir = repoDir
-	fmt.Println("Building project...")
-	buildOutput, _ := buildCmd.CombinedOutput()
-	fmt.Println("Build output:", string(buildOutput))
-
-	// Find build directory
 	buildDirs := []string{"dist", "build", "public", "out", "_site"}
-	var buildDir string
 	for _, dir := range buildDirs {
 		path := filepath.Join(repoDir, dir)
 		if info, err := os.Stat(path); err == nil && info.IsDir() {
-			buildDir = dir
-			fmt.Println("Found build directory:", buildDir)
-			break
+			job.log.Printf("Found build directory: %s\n", dir)
+			return dir, nil
 		}
 	}
+	job.log.Printf("Warning: No build directory found\n")
+	return "", nil
+}
 
-	if buildDir == "" {
-		fmt.Println("Warning: No build directory found")
+// Hands a built app over to the supervisor and switches the site's traffic
+// to it once it is ready. The executable is relative to repoDir.
+func startSupervisedApp(job *deployJob, repoDir string, args ...string) (string, error) {
+	// Supervised apps run on the host, forks are only previewed as static
+	// sites or in containers
+	if job.Fork {
+		return "", fmt.Errorf("previews of forks can't run a server on the host, use a Dockerfile")
 	}
-
-	// Move files to Deployed folder and clean up
-	err := add_to_deployed_folder(repoDir, deploymentID)
+	dir, err := filepath.Abs(repoDir)
 	if err != nil {
-		fmt.Printf("Warning: Failed to move to Deployed folder: %v\n", err)
+		return "", err
+	}
+	if filepath.IsAbs(args[0]) {
+		// Built artifacts live outside the project
+	} else if strings.ContainsRune(args[0], filepath.Separator) {
+		args[0] = filepath.Join(dir, args[0])
+	} else if path, err := exec.LookPath(args[0]); err == nil {
+		args[0] = path
 	}
 
-	// Return the URL where the project will be accessible
-	return fmt.Sprintf("http://localhost:8000/projects/%s", cleanProjectName), nil
+	// A check configured for the project wins over the manifest's
+	check := projectHealthCheck(job.Project)
+	if check == nil {
+		check = job.manifest.HealthCheck
+	}
+
+	return switchSlots(job, appSpec{
+		Name:        job.siteName(),
+		Project:     job.Project,
+		Environment: job.Environment,
+		Untrusted:   job.Fork,
+		Dir:         dir,
+		Args:        args,
+		HealthCheck: check,
+		Vars:        append(job.manifest.envList(), job.appVars...),
+	})
 }
 
-// Deployment function for Go apps
-func deployGoApp(repoDir, deploymentID string) (string, error) {
-	buildCmd := exec.Command("go", "build", "-o", deploymentID)
-	buildCmd.Dir = repoDir
-	if err := buildCmd.Run(); err != nil {
+// containerPath is the PATH of the toolchain images
+const containerPath = "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+
+// startToolchainApp runs an app in its toolchain's build image with the
+// workspace mounted, so paths baked in by container builds stay valid
+func startToolchainApp(job *deployJob, repoDir, toolchain string, command ...string) (string, error) {
+	dir, err := filepath.Abs(repoDir)
+	if err != nil {
 		return "", err
 	}
+	check := projectHealthCheck(job.Project)
+	if check == nil {
+		check = job.manifest.HealthCheck
+	}
+	return switchSlots(job, appSpec{
+		Name:        job.siteName(),
+		Project:     job.Project,
+		Environment: job.Environment,
+		Untrusted:   job.Fork,
+		Dir:         dir,
+		Image:       toolchainImage(toolchain, job.manifest.Runtime),
+		Command:     command,
+		Mounts:      []string{dir},
+		HealthCheck: check,
+		Vars:        append(job.manifest.envList(), job.appVars...),
+	})
+}
+
+// Deployment function for Go apps. The main package is built with
+// -trimpath into the artifacts directory using the project's module cache.
+func deployGoApp(job *deployJob, repoDir string) (string, error) {
+	job.setStatus(statusBuilding)
 
-	port := 8000 + (time.Now().Unix() % 1000)
-	runCmd := exec.Command(filepath.Join(repoDir, deploymentID))
-	runCmd.Dir = repoDir
-	runCmd.Env = append(os.Environ(), fmt.Sprintf("PORT=%d", port))
-	if err := runCmd.Start(); err != nil {
+	modCache, err := goModCache(job, repoDir)
+	if err != nil {
 		return "", err
 	}
+	if err := os.MkdirAll(modCache, 0755); err != nil {
+		return "", fmt.Errorf("failed to create module cache: %v", err)
+	}
+	// Only the build steps see the module cache
+	env := job.env
+	defer func() { job.env, job.mounts = env, nil }()
+	job.env = append(append([]string{}, env...), goBuildEnv(env, modCache, job.manifest.Go)...)
+	job.mounts = []string{modCache}
+
+	// A custom build command leaves the start command to the manifest too
+	if job.manifest.Build != "" {
+		if job.manifest.Start == "" {
+			return "", fmt.Errorf("a start command is required when the manifest sets build")
+		}
+		err := job.withBuildCache(repoDir, "go", func() error {
+			return job.buildStep(repoDir, "go", job.manifest.Build)
+		})
+		if err != nil {
+			return "", err
+		}
+		job.setStatus(statusPublishing)
+		return startSupervisedApp(job, repoDir, "sh", "-c", job.manifest.Start)
+	}
 
-	return fmt.Sprintf("http://localhost:%d", port), nil
+	pkg, err := selectMainPackage(repoDir, job.manifest.Go)
+	if err != nil {
+		return "", err
+	}
+	outDir, err := filepath.Abs(artifactDir(job.siteName(), job.ID))
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create artifact directory: %v", err)
+	}
+	binary := filepath.Join(outDir, binaryName(pkg))
+
+	job.mounts = []string{outDir, modCache}
+	job.log.Printf("Building Go package %s...\n", pkg)
+	err = job.withArtifactCache(outDir, goBuildArgs(pkg, "", job.manifest.Go), func() error {
+		return job.withBuildCache(repoDir, "go", func() error {
+			return job.buildStep(repoDir, "go", "", goBuildArgs(pkg, binary, job.manifest.Go)...)
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+
+	job.setStatus(statusPublishing)
+	defer pruneArtifacts(job.siteName())
+	if job.manifest.Start != "" {
+		job.appVars = append(job.appVars, "APP_BINARY="+binary)
+		return startSupervisedApp(job, repoDir, "sh", "-c", job.manifest.Start)
+	}
+	return startSupervisedApp(job, repoDir, binary)
 }
 
 // Deployment function for Python apps
-func deployPythonApp(repoDir, deploymentID string) (string, error) {
-	venvCmd := exec.Command("python", "-m", "venv", "venv")
-	venvCmd.Dir = repoDir
-	if err := venvCmd.Run(); err != nil {
+func deployPythonApp(job *deployJob, repoDir string) (string, error) {
+	installer := detectPythonInstaller(repoDir)
+
+	job.setStatus(statusInstalling)
+	err := job.withBuildCache(repoDir, "python", func() error {
+		if job.manifest.Install != "" {
+			return job.buildStep(repoDir, "python", job.manifest.Install)
+		}
+		return job.installPython(repoDir, installer)
+	})
+	if err != nil {
 		return "", err
 	}
 
-	var pipCmd *exec.Cmd
-	if runtime.GOOS == "windows" {
-		pipCmd = exec.Command("venv\\Scripts\\pip", "install", "-r", "requirements.txt")
-	} else {
-		pipCmd = exec.Command("venv/bin/pip", "install", "-r", "requirements.txt")
-	}
-	pipCmd.Dir = repoDir
-	if err := pipCmd.Run(); err != nil {
-		return "", err
+	if job.manifest.Build != "" {
+		job.setStatus(statusBuilding)
+		if err := job.buildStep(repoDir, "python", job.manifest.Build); err != nil {
+			return "", err
+		}
 	}
 
-	// Run app (assuming a Flask or Django app)
-	port := 5000 + (time.Now().Unix() % 1000)
-	var runCmd *exec.Cmd
-	if runtime.GOOS == "windows" {
-		runCmd = exec.Command("venv\\Scripts\\python", "app.py")
-	} else {
-		runCmd = exec.Command("venv/bin/python", "app.py")
+	// A virtualenv created in a build container links to that image's
+	// interpreter, so the app has to run in the same image
+	inContainer := isolatedBuilds()
+	path, host := os.Getenv("PATH"), "127.0.0.1"
+	if inContainer {
+		path, host = containerPath, "0.0.0.0"
 	}
-	runCmd.Dir = repoDir
-	runCmd.Env = append(os.Environ(), fmt.Sprintf("PORT=%d", port))
-	if err := runCmd.Start(); err != nil {
+
+	// Commands find the virtualenv's python, gunicorn etc. first
+	dir, err := filepath.Abs(repoDir)
+	if err != nil {
 		return "", err
 	}
+	job.appVars = []string{
+		"VIRTUAL_ENV=" + filepath.Join(dir, pythonVenv),
+		"PATH=" + filepath.Join(dir, filepath.Dir(venvBin("python"))) + string(os.PathListSeparator) + path,
+	}
 
-	return fmt.Sprintf("http://localhost:%d", port), nil
+	start := job.manifest.Start
+	if start == "" {
+		command, server, err := pythonStartCommand(repoDir, host)
+		if err != nil {
+			return "", err
+		}
+		// Only our own virtualenv can be topped up with the server
+		if server != "" && job.manifest.Install == "" {
+			if _, err := os.Stat(filepath.Join(repoDir, venvBin(server))); err != nil {
+				job.log.Printf("Installing %s...\n", server)
+				if err := job.build(repoDir, "python", venvBin("pip"), "install", server); err != nil {
+					return "", err
+				}
+			}
+		}
+		start = command
+	}
+
+	job.setStatus(statusPublishing)
+	job.log.Printf("Starting: %s\n", start)
+	if inContainer {
+		return startToolchainApp(job, repoDir, "python", "sh", "-c", "exec "+start)
+	}
+	return startSupervisedApp(job, repoDir, "sh", "-c", "exec "+start)
 }
 
 // Deployment function for static sites
-func deployStaticSite(repoDir, deploymentID string) (string, error) {
-	// Copy to Deployed folder
-	cleanProjectName := strings.Split(filepath.Base(repoDir), "-")[0]
-	projectDir := filepath.Join(deployedDir, cleanProjectName)
-
-	if _, err := os.Stat(projectDir); err == nil {
-		if err := os.RemoveAll(projectDir); err != nil {
-			return "", fmt.Errorf("failed to clean existing deployment: %v", err)
+func deployStaticSite(job *deployJob, repoDir string) (string, error) {
+	// Plain HTML needs no build, site generators come with a preset
+	build := func() error {
+		job.setStatus(statusInstalling)
+		if err := job.buildStep(repoDir, job.toolchain(), job.manifest.Install, job.preset.Install...); err != nil {
+			return fmt.Errorf("install failed: %v", err)
+		}
+		job.setStatus(statusBuilding)
+		if err := job.buildStep(repoDir, job.toolchain(), job.manifest.Build, job.preset.Build...); err != nil {
+			return fmt.Errorf("build failed: %v", err)
 		}
+		return nil
+	}
+	if output := job.staticOutput(); output != "" {
+		err := job.withArtifactCache(filepath.Join(repoDir, output), job.staticBuildInputs(output), build)
+		if err != nil {
+			return "", err
+		}
+	} else if err := build(); err != nil {
+		return "", err
 	}
 
-	if err := os.MkdirAll(projectDir, 0755); err != nil {
-		return "", fmt.Errorf("failed to create deployment directory: %v", err)
+	siteDir := repoDir
+	if job.manifest.Output != "" || job.preset.Output != "" {
+		buildDir, err := findBuildDir(job, repoDir)
+		if err != nil {
+			return "", err
+		}
+		job.setBuildDir(buildDir)
+		siteDir = filepath.Join(repoDir, buildDir)
 	}
 
-	if err := copyDirectory(repoDir, projectDir); err != nil {
-		return "", fmt.Errorf("failed to copy files: %v", err)
+	// Copy to Deployed folder
+	job.setStatus(statusPublishing)
+	if err := publishRelease(job.siteName(), job.ID, siteDir); err != nil {
+		return "", fmt.Errorf("failed to publish release: %v", err)
+	}
+	if err := applySiteSettings(job.siteName(), siteSettings{SPA: job.manifest.spaFallback()}); err != nil {
+		job.log.Printf("Warning: %v\n", err)
 	}
 
-	return fmt.Sprintf("http://localhost:8000/projects/%s", cleanProjectName), nil
+	return fmt.Sprintf("http://localhost:8000/projects/%s", job.siteName()), nil
 }
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestSelectRepoRejectsInvalidNames(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/select-repo", selectRepoHandler)
+
+	for _, name := range []string{"..", ".", "../other", "a/b", "repo name", "repo\nname", strings.Repeat("a", 101), "sité"} {
+		body, _ := json.Marshal(gin.H{"repo_name": name})
+		req := httptest.NewRequest(http.MethodPost, "/select-repo", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.AddCookie(&http.Cookie{Name: "access_token", Value: "gho_test"})
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("repo_name %q: status = %d, want %d", name, w.Code, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestIsRepoName(t *testing.T) {
+	for _, name := range []string{"site", "go-basics", "my_repo.v2", ".github", "A"} {
+		if !isRepoName(name) {
+			t.Errorf("isRepoName(%q) = false, want true", name)
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Toolchain versions are installed under HOSTER_TOOLCHAINS_DIR as
+// <toolchain>/<version>/bin, e.g. node/20.11.1/bin/node.
+
+func toolchainsDir() string {
+	return os.Getenv("HOSTER_TOOLCHAINS_DIR")
+}
+
+// toolchainBinDir returns the bin directory of an installed toolchain
+// version, empty if it is not installed. Partial versions and ranges such
+// as "20" pick the newest installed version matching them.
+func toolchainBinDir(toolchain, version string) string {
+	if toolchainsDir() == "" || toolchain == "" || version == "" {
+		return ""
+	}
+	if _, ok := parseVersion(version); !ok {
+		resolved, err := resolveToolchainVersion(toolchain, version)
+		if err != nil {
+			return ""
+		}
+		version = resolved
+	}
+	dir := filepath.Join(toolchainsDir(), toolchain, version, "bin")
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return ""
+	}
+	return dir
+}
+
+// installedVersions lists the versions of a toolchain in the toolchains
+// directory, newest first
+func installedVersions(toolchain string) []string {
+	if toolchainsDir() == "" {
+		return nil
+	}
+	entries, err := os.ReadDir(filepath.Join(toolchainsDir(), toolchain))
+	if err != nil {
+		return nil
+	}
+
+	var versions []string
+	for _, entry := range entries {
+		if _, ok := parseVersion(entry.Name()); ok && entry.IsDir() {
+			versions = append(versions, entry.Name())
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		a, _ := parseVersion(versions[i])
+		b, _ := parseVersion(versions[j])
+		return compareVersions(a, b) > 0
+	})
+	return versions
+}
+
+// resolveToolchainVersion picks the newest installed version matching an
+// npm style range such as ">=18", "^20.10" or "18.x || 20.x"
+func resolveToolchainVersion(toolchain, constraint string) (string, error) {
+	r, err := parseVersionRange(constraint)
+	if err != nil {
+		return "", err
+	}
+	installed := installedVersions(toolchain)
+	if len(installed) == 0 {
+		return "", fmt.Errorf("no %s versions installed in HOSTER_TOOLCHAINS_DIR", toolchain)
+	}
+	for _, version := range installed {
+		v, _ := parseVersion(version)
+		if r.matches(v) {
+			return version, nil
+		}
+	}
+	return "", fmt.Errorf("no installed %s version satisfies %q (installed: %s)", toolchain, constraint, strings.Join(installed, ", "))
+}
+
+type version [3]int
+
+var versionPattern = regexp.MustCompile(`^v?(\d+)\.(\d+)\.(\d+)$`)
+
+func parseVersion(s string) (version, bool) {
+	m := versionPattern.FindStringSubmatch(s)
+	if m == nil {
+		return version{}, false
+	}
+	var v version
+	for i := range v {
+		v[i], _ = strconv.Atoi(m[i+1])
+	}
+	return v, true
+}
+
+func compareVersions(a, b version) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// comparator is a single bound like ">=18.0.0"
+type comparator struct {
+	op string
+	v  version
+}
+
+func (c comparator) matches(v version) bool {
+	cmp := compareVersions(v, c.v)
+	switch c.op {
+	case ">=":
+		return cmp >= 0
+	case ">":
+		return cmp > 0
+	case "<=":
+		return cmp <= 0
+	case "<":
+		return cmp < 0
+	}
+	return cmp == 0
+}
+
+// versionRange is a union of comparator sets, all comparators of a set
+// have to match
+type versionRange [][]comparator
+
+func (r versionRange) matches(v version) bool {
+	for _, set := range r {
+		ok := true
+		for _, c := range set {
+			if !c.matches(v) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// partialVersion is a version where trailing parts may be missing or x
+type partialVersion struct {
+	v     version
+	parts int
+}
+
+func parsePartialVersion(s string) (partialVersion, error) {
+	s = strings.TrimPrefix(s, "v")
+	if s == "" || s == "*" || s == "x" || s == "X" {
+		return partialVersion{}, nil
+	}
+	// Pre-release and build suffixes are ignored
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		s = s[:i]
+	}
+
+	var p partialVersion
+	for i, part := range strings.Split(s, ".") {
+		if i >= 3 {
+			return p, fmt.Errorf("invalid version %q", s)
+		}
+		if part == "x" || part == "X" || part == "*" {
+			break
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return p, fmt.Errorf("invalid version %q", s)
+		}
+		p.v[i] = n
+		p.parts = i + 1
+	}
+	return p, nil
+}
+
+// next returns the first version after every version p stands for
+func (p partialVersion) next() version {
+	v := p.v
+	switch p.parts {
+	case 1:
+		return version{v[0] + 1, 0, 0}
+	case 2:
+		return version{v[0], v[1] + 1, 0}
+	}
+	return version{v[0], v[1], v[2] + 1}
+}
+
+var rangeOpPattern = regexp.MustCompile(`^(\^|~|>=|<=|>|<|=)?\s*(.*)$`)
+
+// parseVersionRange understands the subset of npm ranges used in
+// engines fields: x-ranges, ^, ~, comparisons, hyphen ranges and ||
+func parseVersionRange(s string) (versionRange, error) {
+	var r versionRange
+	for _, alternative := range strings.Split(s, "||") {
+		fields := strings.Fields(alternative)
+		// "1.2 - 2.3" is >=1.2 <=2.3
+		if len(fields) == 3 && fields[1] == "-" {
+			fields = []string{">=" + fields[0], "<=" + fields[2]}
+		}
+
+		set := []comparator{}
+		for i := 0; i < len(fields); i++ {
+			field := fields[i]
+			// Allow a space between operator and version, e.g. ">= 18"
+			if (field == ">=" || field == "<=" || field == ">" || field == "<" || field == "=" || field == "^" || field == "~") && i+1 < len(fields) {
+				i++
+				field += fields[i]
+			}
+			comparators, err := parseComparator(field)
+			if err != nil {
+				return nil, err
+			}
+			set = append(set, comparators...)
+		}
+		r = append(r, set)
+	}
+	return r, nil
+}
+
+func parseComparator(s string) ([]comparator, error) {
+	m := rangeOpPattern.FindStringSubmatch(s)
+	op, p := m[1], m[2]
+	pv, err := parsePartialVersion(p)
+	if err != nil {
+		return nil, err
+	}
+	if pv.parts == 0 {
+		switch op {
+		case "<", ">":
+			// Nothing is below or above every version
+			return []comparator{{"<", version{}}}, nil
+		}
+		return nil, nil
+	}
+
+	lower := pv.v
+	switch op {
+	case "", "=":
+		if pv.parts == 3 {
+			return []comparator{{"=", lower}}, nil
+		}
+		return []comparator{{">=", lower}, {"<", pv.next()}}, nil
+	case ">=":
+		return []comparator{{">=", lower}}, nil
+	case ">":
+		if pv.parts == 3 {
+			return []comparator{{">", lower}}, nil
+		}
+		return []comparator{{">=", pv.next()}}, nil
+	case "<":
+		return []comparator{{"<", lower}}, nil
+	case "<=":
+		if pv.parts == 3 {
+			return []comparator{{"<=", lower}}, nil
+		}
+		return []comparator{{"<", pv.next()}}, nil
+	case "~":
+		upper := version{lower[0], lower[1] + 1, 0}
+		if pv.parts == 1 {
+			upper = version{lower[0] + 1, 0, 0}
+		}
+		return []comparator{{">=", lower}, {"<", upper}}, nil
+	case "^":
+		// The first non-zero part may not change
+		var upper version
+		switch {
+		case lower[0] > 0 || pv.parts == 1:
+			upper = version{lower[0] + 1, 0, 0}
+		case lower[1] > 0 || pv.parts == 2:
+			upper = version{0, lower[1] + 1, 0}
+		default:
+			upper = version{0, 0, lower[2] + 1}
+		}
+		return []comparator{{">=", lower}, {"<", upper}}, nil
+	}
+	return nil, fmt.Errorf("invalid range %q", s)
+}
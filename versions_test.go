@@ -0,0 +1,113 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseVersionRange(t *testing.T) {
+	tests := []struct {
+		constraint string
+		match      []string
+		miss       []string
+	}{
+		{"20", []string{"20.0.0", "20.11.1"}, []string{"19.9.9", "21.0.0"}},
+		{"20.x", []string{"20.0.0", "20.99.0"}, []string{"21.0.0"}},
+		{"*", []string{"0.0.1", "22.1.0"}, nil},
+		{">=18", []string{"18.0.0", "22.1.0"}, []string{"17.9.9"}},
+		{">= 18.17", []string{"18.17.0", "20.0.0"}, []string{"18.16.9"}},
+		{">18", []string{"19.0.0"}, []string{"18.20.0"}},
+		{"<=18", []string{"18.20.0"}, []string{"19.0.0"}},
+		{"<18.2.0", []string{"18.1.9"}, []string{"18.2.0"}},
+		{"^20.10", []string{"20.10.0", "20.99.0"}, []string{"20.9.0", "21.0.0"}},
+		{"^0.2.3", []string{"0.2.3", "0.2.9"}, []string{"0.3.0"}},
+		{"^0.0.3", []string{"0.0.3"}, []string{"0.0.4"}},
+		{"~18.2", []string{"18.2.0", "18.2.9"}, []string{"18.3.0"}},
+		{"~18", []string{"18.9.0"}, []string{"19.0.0"}},
+		{"=20.11.1", []string{"20.11.1"}, []string{"20.11.0"}},
+		{"v20.11.1", []string{"20.11.1"}, []string{"20.11.2"}},
+		{"18 - 20.10", []string{"18.0.0", "20.10.9"}, []string{"17.0.0", "20.11.0"}},
+		{">=18 <20", []string{"19.9.0"}, []string{"20.0.0"}},
+		{"18.x || 20.x", []string{"18.1.0", "20.1.0"}, []string{"19.0.0", "22.0.0"}},
+		{"20.0.0-rc.1", []string{"20.0.0"}, []string{"20.0.1"}},
+	}
+	for _, tt := range tests {
+		r, err := parseVersionRange(tt.constraint)
+		if err != nil {
+			t.Errorf("parseVersionRange(%q): %v", tt.constraint, err)
+			continue
+		}
+		for _, s := range tt.match {
+			if v, _ := parseVersion(s); !r.matches(v) {
+				t.Errorf("%q doesn't match %s", tt.constraint, s)
+			}
+		}
+		for _, s := range tt.miss {
+			if v, _ := parseVersion(s); r.matches(v) {
+				t.Errorf("%q matches %s", tt.constraint, s)
+			}
+		}
+	}
+}
+
+func TestParseVersionRangeInvalid(t *testing.T) {
+	for _, constraint := range []string{"latest", "1.2.3.4", ">=abc", "../20"} {
+		if _, err := parseVersionRange(constraint); err == nil {
+			t.Errorf("parseVersionRange(%q) succeeded", constraint)
+		}
+	}
+}
+
+func setupToolchains(t *testing.T, toolchain string, versions ...string) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("HOSTER_TOOLCHAINS_DIR", dir)
+	for _, version := range versions {
+		if err := os.MkdirAll(filepath.Join(dir, toolchain, version, "bin"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestResolveToolchainVersion(t *testing.T) {
+	setupToolchains(t, "node", "18.20.4", "20.9.0", "20.11.1", "22.3.0")
+	tests := []struct {
+		constraint string
+		want       string
+	}{
+		{"20", "20.11.1"},
+		{">=18", "22.3.0"},
+		{"^20.0 <20.10", "20.9.0"},
+		{"18.x || 20.x", "20.11.1"},
+	}
+	for _, tt := range tests {
+		got, err := resolveToolchainVersion("node", tt.constraint)
+		if err != nil || got != tt.want {
+			t.Errorf("resolveToolchainVersion(%q) = %q, %v, want %s", tt.constraint, got, err, tt.want)
+		}
+	}
+	if got, err := resolveToolchainVersion("node", "16"); err == nil {
+		t.Errorf("resolveToolchainVersion(16) = %s, want an error", got)
+	}
+}
+
+func TestToolchainBinDir(t *testing.T) {
+	dir := setupToolchains(t, "node", "20.9.0", "20.11.1")
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{"20", filepath.Join(dir, "node", "20.11.1", "bin")},
+		{"20.9.0", filepath.Join(dir, "node", "20.9.0", "bin")},
+		{"20.10.0", ""},
+		{"22", ""},
+		{"../../etc", ""},
+	}
+	for _, tt := range tests {
+		if got := toolchainBinDir("node", tt.version); got != tt.want {
+			t.Errorf("toolchainBinDir(node, %q) = %q, want %q", tt.version, got, tt.want)
+		}
+	}
+}
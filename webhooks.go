@@ -0,0 +1,147 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/RajBhut/go-basics/ent"
+	"github.com/RajBhut/go-basics/ent/project"
+	"github.com/gin-gonic/gin"
+)
+
+// githubPushEvent holds the parts of a GitHub push payload we care about
+type githubPushEvent struct {
+	Ref        string           `json:"ref"`
+	After      string           `json:"after"`
+	Deleted    bool             `json:"deleted"`
+	Repository githubRepository `json:"repository"`
+}
+
+type githubRepository struct {
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	DefaultBranch string `json:"default_branch"`
+}
+
+// owner returns the account part of the repository's full name
+func (r githubRepository) owner() string {
+	owner, _, _ := strings.Cut(r.FullName, "/")
+	return owner
+}
+
+// signWebhookPayload computes the X-Hub-Signature-256 value GitHub sends
+// for body
+func signWebhookPayload(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func verifyWebhookSignature(secret, body []byte, signature string) bool {
+	if len(secret) == 0 || !strings.HasPrefix(signature, "sha256=") {
+		return false
+	}
+	expected := signWebhookPayload(secret, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// Receives GitHub webhooks and redeploys registered projects on push
+func githubWebhookHandler(c *gin.Context) {
+	secret := []byte(os.Getenv("GITHUB_WEBHOOK_SECRET"))
+	if len(secret) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "webhook secret not configured"})
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "could not read payload"})
+		return
+	}
+	if !verifyWebhookSignature(secret, body, c.GetHeader("X-Hub-Signature-256")) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid signature"})
+		return
+	}
+
+	switch c.GetHeader("X-GitHub-Event") {
+	case "ping":
+		c.JSON(http.StatusOK, gin.H{"message": "pong"})
+	case "push":
+		handlePushEvent(c, body)
+	case "pull_request":
+		handlePullRequestEvent(c, body)
+	default:
+		c.JSON(http.StatusAccepted, gin.H{"message": "event ignored"})
+	}
+}
+
+func handlePushEvent(c *gin.Context, body []byte) {
+	var event githubPushEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid payload"})
+		return
+	}
+
+	branch, isBranch := strings.CutPrefix(event.Ref, "refs/heads/")
+	if !isBranch || event.Deleted {
+		c.JSON(http.StatusAccepted, gin.H{"message": "ref ignored"})
+		return
+	}
+
+	projects, err := findRepoProjects(event.Repository)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not look up projects"})
+		return
+	}
+
+	var deployments []string
+	for _, p := range projects {
+		if projectBranch(p, event.Repository) != branch {
+			continue
+		}
+
+		job := newDeployJob(p.Owner, p.Repo, event.After, os.Getenv("GITHUB_DEPLOY_TOKEN"))
+		job.Project = p.Name
+		if err := startDeployment(job); err != nil {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+			return
+		}
+		deployments = append(deployments, job.ID)
+	}
+
+	if len(deployments) == 0 {
+		c.JSON(http.StatusAccepted, gin.H{"message": "no project tracks this branch"})
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{
+		"message":     fmt.Sprintf("Queued %d deployment(s)", len(deployments)),
+		"deployments": deployments,
+	})
+}
+
+// findRepoProjects looks up the projects of a repository a webhook is
+// about. Tests replace it to run without a database.
+var findRepoProjects = projectsForRepo
+
+// projectsForRepo returns the projects deployed from a GitHub repository
+func projectsForRepo(repo githubRepository) ([]*ent.Project, error) {
+	return db.Project.Query().
+		Where(project.Owner(repo.owner()), project.Repo(repo.Name)).
+		All(dbCtx)
+}
+
+// projectBranch is the branch a project redeploys from, defaulting to the
+// repository's default branch
+func projectBranch(p *ent.Project, repo githubRepository) string {
+	if p.Branch != "" {
+		return p.Branch
+	}
+	return repo.DefaultBranch
+}
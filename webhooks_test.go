@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/RajBhut/go-basics/ent"
+	"github.com/gin-gonic/gin"
+)
+
+const testWebhookSecret = "It's a Secret to Everybody"
+
+// setupWebhookTest runs webhooks against projects instead of the database.
+// Jobs are queued without workers, so nothing is deployed.
+func setupWebhookTest(t *testing.T, projects ...*ent.Project) {
+	t.Helper()
+	t.Setenv("GITHUB_WEBHOOK_SECRET", testWebhookSecret)
+	gin.SetMode(gin.TestMode)
+
+	oldFind, oldQueue, oldLogDir := findRepoProjects, deployQueue, deploymentLogDir
+	t.Cleanup(func() {
+		findRepoProjects, deployQueue, deploymentLogDir = oldFind, oldQueue, oldLogDir
+	})
+	deploymentLogDir = t.TempDir()
+	deployQueue = newJobQueue(0, 16)
+	findRepoProjects = func(repo githubRepository) ([]*ent.Project, error) {
+		var found []*ent.Project
+		for _, p := range projects {
+			if p.Owner == repo.owner() && p.Repo == repo.Name {
+				found = append(found, p)
+			}
+		}
+		return found, nil
+	}
+}
+
+func readPayload(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", "webhooks", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+// sendWebhook posts body as a GitHub event with the given signature header,
+// none when it is empty
+func sendWebhook(event string, body []byte, signature string) *httptest.ResponseRecorder {
+	r := gin.New()
+	r.POST("/webhooks/github", githubWebhookHandler)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/github", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", event)
+	if signature != "" {
+		req.Header.Set("X-Hub-Signature-256", signature)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func sendSignedWebhook(event string, body []byte) *httptest.ResponseRecorder {
+	return sendWebhook(event, body, signWebhookPayload([]byte(testWebhookSecret), body))
+}
+
+// queuedJobs returns the jobs of the deployments listed in a response
+func queuedJobs(t *testing.T, w *httptest.ResponseRecorder) []*deployJob {
+	t.Helper()
+	var resp struct {
+		Deployments []string `json:"deployments"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid response %s: %v", w.Body, err)
+	}
+	var jobs []*deployJob
+	for _, id := range resp.Deployments {
+		job, ok := deployQueue.get(id)
+		if !ok {
+			t.Fatalf("deployment %s was not queued", id)
+		}
+		jobs = append(jobs, job)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].Project < jobs[j].Project })
+	return jobs
+}
+
+func TestWebhookPing(t *testing.T) {
+	setupWebhookTest(t)
+	w := sendSignedWebhook("ping", readPayload(t, "ping.json"))
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+}
+
+func TestWebhookSignature(t *testing.T) {
+	setupWebhookTest(t, &ent.Project{Name: "site", Owner: "octo-org", Repo: "site"})
+	body := readPayload(t, "push.json")
+
+	tests := []struct {
+		name      string
+		signature string
+	}{
+		{"missing", ""},
+		{"wrong secret", signWebhookPayload([]byte("not the secret"), body)},
+		{"other body", signWebhookPayload([]byte(testWebhookSecret), readPayload(t, "ping.json"))},
+		{"sha1", "sha1=7d38cdd689735b008b3c702edd92eea23791c5f6"},
+		{"malformed", "sha256=zz"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := sendWebhook("push", body, tt.signature)
+			if w.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusUnauthorized)
+			}
+		})
+	}
+	if len(deployQueue.byID) != 0 {
+		t.Errorf("%d deployments queued for unsigned payloads", len(deployQueue.byID))
+	}
+}
+
+func TestWebhookWithoutSecret(t *testing.T) {
+	setupWebhookTest(t)
+	t.Setenv("GITHUB_WEBHOOK_SECRET", "")
+	body := readPayload(t, "ping.json")
+	if w := sendWebhook("ping", body, signWebhookPayload(nil, body)); w.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestWebhookPushDeploysTrackingProjects(t *testing.T) {
+	setupWebhookTest(t,
+		&ent.Project{Name: "site", Owner: "octo-org", Repo: "site"},
+		&ent.Project{Name: "site-main", Owner: "octo-org", Repo: "site", Branch: "main"},
+		&ent.Project{Name: "site-release", Owner: "octo-org", Repo: "site", Branch: "release"},
+		&ent.Project{Name: "fork", Owner: "someone-else", Repo: "site"},
+	)
+
+	w := sendSignedWebhook("push", readPayload(t, "push.json"))
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusAccepted, w.Body)
+	}
+	jobs := queuedJobs(t, w)
+	if len(jobs) != 2 || jobs[0].Project != "site" || jobs[1].Project != "site-main" {
+		t.Fatalf("deployed %v, want site and site-main", jobs)
+	}
+	for _, job := range jobs {
+		if job.Ref != "0d1a26e67d8f5eaf1f6ba5c57fc3c7d91ac0fd1c" {
+			t.Errorf("%s deploys %q, want the pushed commit", job.Project, job.Ref)
+		}
+		if job.Owner != "octo-org" || job.Repo != "site" || job.Environment != environmentProduction {
+			t.Errorf("%s: unexpected job %s/%s %s", job.Project, job.Owner, job.Repo, job.Environment)
+		}
+	}
+}
+
+func TestWebhookPushIgnored(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		project *ent.Project
+	}{
+		{"tag", "push_tag.json", &ent.Project{Name: "site", Owner: "octo-org", Repo: "site", Branch: "v1.2.0"}},
+		{"deleted branch", "push_deleted.json", &ent.Project{Name: "site", Owner: "octo-org", Repo: "site", Branch: "feature/old"}},
+		{"untracked branch", "push.json", &ent.Project{Name: "site", Owner: "octo-org", Repo: "site", Branch: "release"}},
+		{"unknown repository", "push.json", &ent.Project{Name: "other", Owner: "octo-org", Repo: "other"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupWebhookTest(t, tt.project)
+			w := sendSignedWebhook("push", readPayload(t, tt.payload))
+			if w.Code != http.StatusAccepted {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusAccepted)
+			}
+			if len(deployQueue.byID) != 0 {
+				t.Errorf("%d deployments queued, want none", len(deployQueue.byID))
+			}
+		})
+	}
+}
+
+func TestWebhookUnknownEvent(t *testing.T) {
+	setupWebhookTest(t)
+	w := sendSignedWebhook("star", []byte(`{"action":"created"}`))
+	if w.Code != http.StatusAccepted {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusAccepted)
+	}
+}